@@ -2,13 +2,27 @@ package main
 
 import (
 	"job-tracker/internal/bootstrap"
-	"log"
+	"os"
+
+	"github.com/spf13/cobra"
 )
 
 func main() {
 
-	if err := bootstrap.Start(); err != nil {
-		log.Fatal(err)
+	cmd := &cobra.Command{
+		Use:          "api",
+		Short:        "Job Tracker API server",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return bootstrap.Start(cmd.Flags())
+		},
+	}
+	bootstrap.BindConfigFlags(cmd.PersistentFlags())
+	cmd.AddCommand(bootstrap.NewMigrateCommand(), bootstrap.NewConfigCommand())
+
+	if err := cmd.Execute(); err != nil {
+		os.Exit(1)
 	}
 
 }
@@ -0,0 +1,14 @@
+package main
+
+import (
+	"job-tracker/internal/cli"
+	"os"
+)
+
+func main() {
+
+	if err := cli.Execute(); err != nil {
+		os.Exit(1)
+	}
+
+}
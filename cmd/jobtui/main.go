@@ -0,0 +1,41 @@
+package main
+
+import (
+	"job-tracker/internal/client"
+	"job-tracker/internal/tui"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func main() {
+
+	v := viper.New()
+	var configFile string
+
+	cmd := &cobra.Command{
+		Use:          "jobtui",
+		Short:        "Kanban board of job applications",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := client.LoadConfig(v, configFile); err != nil {
+				return err
+			}
+			backend, err := client.Open(v)
+			if err != nil {
+				return err
+			}
+			defer backend.Close()
+			return tui.Run(backend)
+		},
+	}
+	cmd.Flags().StringVar(&configFile, "config", "", "config file (default "+client.DefaultConfigFile()+")")
+	client.BindFlags(cmd.Flags(), v)
+
+	if err := cmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+
+}
@@ -0,0 +1,100 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"job-tracker/internal/domain"
+	"time"
+)
+
+type ArchiveService struct {
+	repository domain.JobRepository
+	rules      []domain.ArchiveRule
+	log        domain.Logger
+}
+
+func NewArchiveService(repository domain.JobRepository, log domain.Logger) *ArchiveService {
+	return &ArchiveService{
+		repository: repository,
+		rules:      domain.DefaultArchiveRules,
+		log:        log,
+	}
+}
+
+func (s *ArchiveService) ArchiveJobs(request *ArchiveJobsRequest, ctx context.Context) ([]*domain.Job, error) {
+	s.log.Info(ctx, "archiving jobs", domain.Field{Key: "count", Value: len(request.Ids)})
+	return s.apply(request, func(job *domain.Job) bool { return job.Archive(domain.FieldSourceUser) }, ctx)
+}
+
+func (s *ArchiveService) UnarchiveJobs(request *ArchiveJobsRequest, ctx context.Context) ([]*domain.Job, error) {
+	s.log.Info(ctx, "unarchiving jobs", domain.Field{Key: "count", Value: len(request.Ids)})
+	return s.apply(request, (*domain.Job).Unarchive, ctx)
+}
+
+// apply changes every job in one transaction, so an unknown id, a version
+// conflict or a failed write leaves all of them as they were.
+func (s *ArchiveService) apply(request *ArchiveJobsRequest, change func(*domain.Job) bool, ctx context.Context) ([]*domain.Job, error) {
+	jobs := make([]*domain.Job, 0, len(request.Ids))
+	err := s.repository.Transaction(func(repository domain.JobRepository) error {
+		for _, id := range request.Ids {
+			job, err := repository.GetJobById(id.String(), ctx)
+			if err != nil {
+				s.log.Error(ctx, "failed to get job to archive", err, domain.Field{Key: "job_id", Value: id.String()})
+				return domain.ErrJobNotFound
+			}
+			jobs = append(jobs, job)
+		}
+		for _, job := range jobs {
+			if !change(job) {
+				continue
+			}
+			if err := repository.UpdateJob(job, ctx); err != nil {
+				s.log.Error(ctx, "failed to update job archive flag", err, domain.Field{Key: "job_id", Value: job.Id.String()})
+				return err
+			}
+		}
+		return nil
+	}, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// ApplyRules archives every job matched by an archive rule. Jobs that change
+// concurrently are left for the next run.
+func (s *ArchiveService) ApplyRules(now time.Time, ctx context.Context) error {
+	archived := 0
+	for _, rule := range s.rules {
+		jobs, err := s.ruleCandidates(rule, ctx)
+		if err != nil {
+			s.log.Error(ctx, "failed to get jobs for archive rule", err, domain.Field{Key: "status", Value: string(rule.Status)})
+			return err
+		}
+		for _, job := range jobs {
+			if !rule.Matches(job, now) || !job.Archive(domain.FieldSourceRule) {
+				continue
+			}
+			err := s.repository.UpdateJob(job, ctx)
+			if errors.Is(err, domain.ErrVersionConflict) {
+				continue
+			}
+			if err != nil {
+				s.log.Error(ctx, "failed to archive job", err, domain.Field{Key: "job_id", Value: job.Id.String()})
+				return err
+			}
+			archived++
+		}
+	}
+	if archived > 0 {
+		s.log.Info(ctx, "archived jobs by rule", domain.Field{Key: "count", Value: archived})
+	}
+	return nil
+}
+
+func (s *ArchiveService) ruleCandidates(rule domain.ArchiveRule, ctx context.Context) ([]*domain.Job, error) {
+	if rule.PostingClosed {
+		return s.repository.GetAll(false, ctx)
+	}
+	return s.repository.GetJobsByStatus(rule.Status, false, ctx)
+}
@@ -0,0 +1,118 @@
+package application
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+	"sync"
+	"time"
+)
+
+// EventRelay publishes outbox events to each subscriber in sequence order.
+// Every subscriber keeps its own checkpoint, so a failing subscriber retries
+// from where it stopped without blocking or re-delivering to the others.
+// Local subscribers keep theirs in memory, starting at the latest event when
+// the process boots.
+//
+// Sequences are assigned on insert but become visible on commit, so a gap
+// may be a transaction that has not committed yet. The relay stops at a gap
+// until the event after it is older than gapTimeout; by then the gap is taken
+// to be a rolled back transaction and skipped.
+type EventRelay struct {
+	outbox      domain.OutboxRepository
+	subscribers []domain.EventSubscriber
+	batchSize   int
+	gapTimeout  time.Duration
+	log         domain.Logger
+
+	mu        sync.Mutex
+	positions map[string]int64
+}
+
+func NewEventRelay(outbox domain.OutboxRepository, subscribers []domain.EventSubscriber, log domain.Logger) *EventRelay {
+	return &EventRelay{
+		outbox:      outbox,
+		subscribers: subscribers,
+		batchSize:   100,
+		gapTimeout:  30 * time.Second,
+		log:         log,
+		positions:   make(map[string]int64),
+	}
+}
+
+func NewEventSubscribers(reminders *ReminderService, notifications *NotificationService, webhooks *WebhookService, stream *EventStream) []domain.EventSubscriber {
+	return []domain.EventSubscriber{reminders, notifications, webhooks, stream}
+}
+
+func (r *EventRelay) RelayPending(ctx context.Context) error {
+	for _, subscriber := range r.subscribers {
+		if err := r.relay(subscriber, ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *EventRelay) relay(subscriber domain.EventSubscriber, ctx context.Context) error {
+	checkpoint, err := r.checkpoint(subscriber, ctx)
+	if err != nil {
+		r.log.Error(ctx, "failed to get outbox checkpoint", err, domain.Field{Key: "subscriber", Value: subscriber.Name()})
+		return err
+	}
+
+	events, err := r.outbox.GetEventsAfter(checkpoint, r.batchSize, ctx)
+	if err != nil {
+		r.log.Error(ctx, "failed to read outbox", err)
+		return err
+	}
+
+	last := checkpoint
+	for _, event := range events {
+		if event.Sequence != last+1 && time.Since(event.OccurredAt) < r.gapTimeout {
+			break
+		}
+		if err := subscriber.Handle(ctx, event); err != nil {
+			r.log.Error(ctx, "subscriber failed to handle event", err,
+				domain.Field{Key: "subscriber", Value: subscriber.Name()},
+				domain.Field{Key: "sequence", Value: event.Sequence},
+			)
+			break
+		}
+		last = event.Sequence
+	}
+
+	if last == checkpoint {
+		return nil
+	}
+	if err := r.saveCheckpoint(subscriber, last, ctx); err != nil {
+		r.log.Error(ctx, "failed to save outbox checkpoint", err, domain.Field{Key: "subscriber", Value: subscriber.Name()})
+		return err
+	}
+	return nil
+}
+
+func (r *EventRelay) checkpoint(subscriber domain.EventSubscriber, ctx context.Context) (int64, error) {
+	if _, ok := subscriber.(domain.LocalSubscriber); !ok {
+		return r.outbox.GetCheckpoint(subscriber.Name(), ctx)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if position, ok := r.positions[subscriber.Name()]; ok {
+		return position, nil
+	}
+	latest, err := r.outbox.GetLatestSequence(ctx)
+	if err != nil {
+		return 0, err
+	}
+	r.positions[subscriber.Name()] = latest
+	return latest, nil
+}
+
+func (r *EventRelay) saveCheckpoint(subscriber domain.EventSubscriber, sequence int64, ctx context.Context) error {
+	if _, ok := subscriber.(domain.LocalSubscriber); !ok {
+		return r.outbox.SaveCheckpoint(subscriber.Name(), sequence, ctx)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.positions[subscriber.Name()] = sequence
+	return nil
+}
@@ -0,0 +1,127 @@
+package application
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// EventStream fans relayed outbox events out to live listeners, such as the
+// SSE endpoint. A listener that falls behind is dropped and its channel
+// closed; it is expected to subscribe again and resume from the outbox using
+// the last sequence it saw.
+type EventStream struct {
+	outbox    domain.OutboxRepository
+	mu        sync.RWMutex
+	listeners map[chan domain.Event]struct{}
+	buffer    int
+	log       domain.Logger
+}
+
+type EventFilter struct {
+	Types []domain.EventType
+	JobId uuid.UUID
+	// UserId keeps the events of jobs tracked by that user.
+	UserId string
+}
+
+func NewEventStream(outbox domain.OutboxRepository, log domain.Logger) *EventStream {
+	return &EventStream{
+		outbox:    outbox,
+		listeners: make(map[chan domain.Event]struct{}),
+		buffer:    64,
+		log:       log,
+	}
+}
+
+func (s *EventStream) Name() string {
+	return "stream"
+}
+
+// Local marks the stream as in-process: each replica relays to its own
+// listeners.
+func (s *EventStream) Local() {}
+
+func (s *EventStream) Handle(ctx context.Context, event domain.Event) error {
+	var full []chan domain.Event
+	s.mu.RLock()
+	for listener := range s.listeners {
+		select {
+		case listener <- event:
+		default:
+			full = append(full, listener)
+		}
+	}
+	s.mu.RUnlock()
+
+	for _, listener := range full {
+		s.log.Debug(ctx, "dropping slow stream listener", domain.Field{Key: "sequence", Value: event.Sequence})
+		s.remove(listener)
+	}
+	return nil
+}
+
+// Subscribe registers a listener and returns a function that removes it. The
+// channel is closed when the listener is removed, including when it falls
+// behind.
+func (s *EventStream) Subscribe() (<-chan domain.Event, func()) {
+	listener := make(chan domain.Event, s.buffer)
+	s.mu.Lock()
+	s.listeners[listener] = struct{}{}
+	s.mu.Unlock()
+
+	return listener, func() { s.remove(listener) }
+}
+
+func (s *EventStream) remove(listener chan domain.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.listeners[listener]; ok {
+		delete(s.listeners, listener)
+		close(listener)
+	}
+}
+
+func (s *EventStream) Replay(after int64, limit int, ctx context.Context) ([]domain.Event, error) {
+	events, err := s.outbox.GetEventsAfter(after, limit, ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to replay events", err)
+		return nil, err
+	}
+	return events, nil
+}
+
+// History returns the last events of a job, oldest first.
+func (s *EventStream) History(jobId uuid.UUID, limit int, ctx context.Context) ([]domain.Event, error) {
+	events, err := s.outbox.GetEventsForJob(jobId.String(), limit, ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to get job history", err, domain.Field{Key: "job_id", Value: jobId.String()})
+		return nil, err
+	}
+	return events, nil
+}
+
+func (s *EventStream) LatestSequence(ctx context.Context) (int64, error) {
+	sequence, err := s.outbox.GetLatestSequence(ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to get latest event sequence", err)
+		return 0, err
+	}
+	return sequence, nil
+}
+
+func (f EventFilter) Matches(event domain.Event) bool {
+	if len(f.Types) > 0 && !slices.Contains(f.Types, event.Type) {
+		return false
+	}
+	if f.JobId != uuid.Nil && f.JobId != event.JobId {
+		return false
+	}
+	if f.UserId != "" && (event.Job == nil || event.Job.UserId != f.UserId) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,149 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"job-tracker/internal/domain"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// BulkResult is the outcome of one bulk operation, in request order.
+type BulkResult struct {
+	Index int
+	Op    BulkOperationKind
+	Job   *domain.Job
+	Err   error
+}
+
+// errBulkFailed aborts the atomic transaction once an item has failed; the
+// item's own error is already in its result.
+var errBulkFailed = errors.New("bulk item failed")
+
+// Bulk runs the operations in one transaction. In atomic mode the first
+// failure rolls everything back and the other items report
+// domain.ErrBulkAborted; in best-effort mode each item rolls back on its own.
+func (s *JobService) Bulk(request *BulkJobsRequest, ctx context.Context) (_ []BulkResult, err error) {
+	ctx, span := s.tracer.Start(ctx, "JobService.Bulk", trace.WithAttributes(
+		attribute.Int("job.count", len(request.Operations)),
+		attribute.String("job.bulk.mode", string(request.Mode)),
+	))
+	defer func() { EndSpan(span, err) }()
+
+	s.log.Info(ctx, "running bulk job operations",
+		domain.Field{Key: "count", Value: len(request.Operations)},
+		domain.Field{Key: "mode", Value: string(request.Mode)},
+	)
+	results := make([]BulkResult, len(request.Operations))
+	for i, operation := range request.Operations {
+		results[i] = BulkResult{Index: i, Op: operation.Op, Err: domain.ErrBulkAborted}
+	}
+
+	err = s.repository.Transaction(func(repository domain.JobRepository) error {
+		for i, operation := range request.Operations {
+			if request.Mode == BulkModeBestEffort {
+				_ = repository.Transaction(func(repository domain.JobRepository) error {
+					results[i].Job, results[i].Err = s.withRepository(repository).runBulkOperation(&operation, ctx)
+					return results[i].Err
+				}, ctx)
+				continue
+			}
+			results[i].Job, results[i].Err = s.withRepository(repository).runBulkOperation(&operation, ctx)
+			if results[i].Err != nil {
+				return errBulkFailed
+			}
+		}
+		return nil
+	}, ctx)
+	if errors.Is(err, errBulkFailed) {
+		for i := range results {
+			if results[i].Err == nil {
+				results[i].Job, results[i].Err = nil, domain.ErrBulkAborted
+			}
+		}
+		return results, nil
+	}
+	if err != nil {
+		s.log.Error(ctx, "failed to run bulk job operations", err)
+		return nil, err
+	}
+	return results, nil
+}
+
+func (s *JobService) withRepository(repository domain.JobRepository) *JobService {
+	return &JobService{repository: repository, log: s.log, tracer: s.tracer}
+}
+
+func (s *JobService) runBulkOperation(operation *BulkOperation, ctx context.Context) (*domain.Job, error) {
+	if operation.Op != BulkOperationCreate && operation.Id == uuid.Nil {
+		return nil, domain.ErrInvalidRequest
+	}
+	switch operation.Op {
+	case BulkOperationCreate:
+		if !validBulkFields(operation) {
+			return nil, domain.ErrInvalidRequest
+		}
+		return s.CreateJob(&CreateJobRequest{
+			Company:     operation.Company,
+			Position:    operation.Position,
+			Description: operation.Description,
+			Salary:      operation.Salary,
+			Remote:      operation.Remote,
+			Url:         operation.Url,
+		}, ctx)
+	case BulkOperationUpdate:
+		if !validBulkFields(operation) {
+			return nil, domain.ErrInvalidRequest
+		}
+		return s.UpdateJob(&UpdateJobRequest{
+			Id:          operation.Id,
+			Company:     operation.Company,
+			Position:    operation.Position,
+			Description: operation.Description,
+			Salary:      operation.Salary,
+			Remote:      operation.Remote,
+			Url:         operation.Url,
+			Version:     operation.Version,
+		}, ctx)
+	case BulkOperationStatus:
+		return s.UpdateJobStatus(operation.Id, &UpdateJobStatusRequest{Status: operation.Status, Version: operation.Version}, ctx)
+	case BulkOperationDelete:
+		return nil, s.DeleteJob(operation.Id, ctx)
+	case BulkOperationTag:
+		return s.TagJob(operation.Id, operation.AddTags, operation.RemoveTags, operation.Version, ctx)
+	default:
+		return nil, domain.ErrInvalidRequest
+	}
+}
+
+func validBulkFields(operation *BulkOperation) bool {
+	return len(operation.Company) >= 2 && len(operation.Position) >= 2 && len(operation.Description) >= 2
+}
+
+func (s *JobService) TagJob(id uuid.UUID, add []string, remove []string, version int, ctx context.Context) (_ *domain.Job, err error) {
+	ctx, span := s.tracer.Start(ctx, "JobService.TagJob", trace.WithAttributes(jobIdAttribute(id.String())))
+	defer func() { EndSpan(span, err) }()
+
+	s.log.Info(ctx, "tagging job", domain.Field{Key: "job_id", Value: id.String()})
+	if len(add) == 0 && len(remove) == 0 {
+		return nil, domain.ErrInvalidRequest
+	}
+	job, err := s.repository.GetJobById(id.String(), ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to get job to tag", err)
+		return nil, domain.ErrJobNotFound
+	}
+	if version != 0 && version != job.Version {
+		return nil, domain.ErrVersionConflict
+	}
+	if !job.Tag(add, remove) {
+		return job, nil
+	}
+	if err = s.repository.UpdateJob(job, ctx); err != nil {
+		s.log.Error(ctx, "failed to tag job", err)
+		return nil, err
+	}
+	return job, nil
+}
@@ -0,0 +1,74 @@
+package application
+
+import (
+	"bytes"
+	"encoding/json"
+	"job-tracker/internal/domain"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+)
+
+// jobDocument is the patchable view of a job.
+type jobDocument struct {
+	Company     string `json:"company"`
+	Position    string `json:"position"`
+	Description string `json:"description"`
+	Status      string `json:"status"`
+	Salary      int    `json:"salary"`
+	Remote      bool   `json:"remote"`
+	Url         string `json:"url"`
+	Notes       string `json:"notes"`
+}
+
+func newJobDocument(job *domain.Job) jobDocument {
+	return jobDocument{
+		Company:     job.Company,
+		Position:    job.Position,
+		Description: job.Description,
+		Status:      string(job.Status),
+		Salary:      job.Salary,
+		Remote:      job.Remote,
+		Url:         job.Url,
+		Notes:       job.Notes,
+	}
+}
+
+// applyPatch returns the patched document and whether it differs from the original.
+func applyPatch(job *domain.Job, request *PatchJobRequest) (jobDocument, bool, error) {
+	original, err := json.Marshal(newJobDocument(job))
+	if err != nil {
+		return jobDocument{}, false, err
+	}
+
+	var patched []byte
+	switch request.Format {
+	case PatchFormatMerge:
+		patched, err = jsonpatch.MergePatch(original, request.Patch)
+	case PatchFormatJSON:
+		var patch jsonpatch.Patch
+		patch, err = jsonpatch.DecodePatch(request.Patch)
+		if err == nil {
+			patched, err = patch.Apply(original)
+		}
+	default:
+		return jobDocument{}, false, domain.ErrInvalidRequest
+	}
+	if err != nil {
+		return jobDocument{}, false, domain.ErrInvalidRequest
+	}
+
+	var document jobDocument
+	decoder := json.NewDecoder(bytes.NewReader(patched))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&document); err != nil {
+		return jobDocument{}, false, domain.ErrInvalidRequest
+	}
+	if len(document.Company) < 2 || len(document.Position) < 2 || len(document.Description) < 2 {
+		return jobDocument{}, false, domain.ErrInvalidRequest
+	}
+	if domain.JobStatusFromString(document.Status) == domain.JobStatusUnknown && document.Status != string(job.Status) {
+		return jobDocument{}, false, domain.ErrInvalidRequest
+	}
+
+	return document, document != newJobDocument(job), nil
+}
@@ -5,24 +5,33 @@ import (
 	"job-tracker/internal/domain"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type JobService struct {
 	repository domain.JobRepository
 	log        domain.Logger
+	tracer     trace.Tracer
 }
 
-func NewJobService(repository domain.JobRepository, log domain.Logger) *JobService {
+func NewJobService(repository domain.JobRepository, log domain.Logger, tracer trace.TracerProvider) *JobService {
 	return &JobService{
 		repository: repository,
 		log:        log,
+		tracer:     tracer.Tracer(tracerName),
 	}
 }
 
-func (s *JobService) CreateJob(request *CreateJobRequest, ctx context.Context) (*domain.Job, error) {
+func (s *JobService) CreateJob(request *CreateJobRequest, ctx context.Context) (_ *domain.Job, err error) {
+	ctx, span := s.tracer.Start(ctx, "JobService.CreateJob")
+	defer func() { EndSpan(span, err) }()
+
 	s.log.Info(ctx, "creating job")
 	job := domain.NewJob(request.Company, request.Position, request.Description, request.Salary, request.Remote, request.Url)
-	err := s.repository.CreateJob(job)
+	job.UserId = request.UserId
+	span.SetAttributes(jobIdAttribute(job.Id.String()))
+	err = s.repository.CreateJob(job, ctx)
 	if err != nil {
 		s.log.Error(ctx, "failed to create job", err)
 		return nil, err
@@ -31,15 +40,21 @@ func (s *JobService) CreateJob(request *CreateJobRequest, ctx context.Context) (
 	return job, nil
 }
 
-func (s *JobService) UpdateJob(request *UpdateJobRequest, ctx context.Context) (*domain.Job, error) {
+func (s *JobService) UpdateJob(request *UpdateJobRequest, ctx context.Context) (_ *domain.Job, err error) {
+	ctx, span := s.tracer.Start(ctx, "JobService.UpdateJob", trace.WithAttributes(jobIdAttribute(request.Id.String())))
+	defer func() { EndSpan(span, err) }()
+
 	s.log.Info(ctx, "updating job", domain.Field{Key: "job_id", Value: request.Id.String()})
-	job, err := s.repository.GetJobById(request.Id.String())
+	job, err := s.repository.GetJobById(request.Id.String(), ctx)
 	if err != nil {
 		s.log.Error(ctx, "failed to get job to update", err)
 		return nil, domain.ErrJobNotFound
 	}
+	if request.Version != 0 && request.Version != job.Version {
+		return nil, domain.ErrVersionConflict
+	}
 	job.Update(request.Company, request.Position, request.Description, request.Salary, request.Remote, request.Url)
-	err = s.repository.UpdateJob(job)
+	err = s.repository.UpdateJob(job, ctx)
 	if err != nil {
 		s.log.Error(ctx, "failed to update job", err)
 		return nil, err
@@ -48,9 +63,82 @@ func (s *JobService) UpdateJob(request *UpdateJobRequest, ctx context.Context) (
 	return job, nil
 }
 
-func (s *JobService) DeleteJob(id uuid.UUID, ctx context.Context) error {
+func (s *JobService) PatchJob(id uuid.UUID, request *PatchJobRequest, ctx context.Context) (_ *domain.Job, err error) {
+	ctx, span := s.tracer.Start(ctx, "JobService.PatchJob", trace.WithAttributes(jobIdAttribute(id.String())))
+	defer func() { EndSpan(span, err) }()
+
+	s.log.Info(ctx, "patching job", domain.Field{Key: "job_id", Value: id.String()})
+	job, err := s.repository.GetJobById(id.String(), ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to get job to patch", err)
+		return nil, domain.ErrJobNotFound
+	}
+	if request.Version != 0 && request.Version != job.Version {
+		return nil, domain.ErrVersionConflict
+	}
+	document, changed, err := applyPatch(job, request)
+	if err != nil {
+		s.log.Error(ctx, "failed to apply patch", err)
+		return nil, err
+	}
+	if !changed {
+		return job, nil
+	}
+	job.Update(document.Company, document.Position, document.Description, document.Salary, document.Remote, document.Url)
+	job.SetNotes(document.Notes)
+	if document.Status != string(job.Status) {
+		job.ChangeStatus(domain.JobStatusFromString(document.Status))
+	}
+	err = s.repository.UpdateJob(job, ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to patch job", err)
+		return nil, err
+	}
+	s.log.Info(ctx, "job patched", domain.Field{Key: "job_id", Value: job.Id.String()})
+	return job, nil
+}
+
+func (s *JobService) UpdateJobStatus(id uuid.UUID, request *UpdateJobStatusRequest, ctx context.Context) (_ *domain.Job, err error) {
+	ctx, span := s.tracer.Start(ctx, "JobService.UpdateJobStatus", trace.WithAttributes(
+		jobIdAttribute(id.String()),
+		attribute.String("job.status", request.Status),
+	))
+	defer func() { EndSpan(span, err) }()
+
+	s.log.Info(ctx, "updating job status", domain.Field{Key: "job_id", Value: id.String()})
+	status := domain.JobStatusFromString(request.Status)
+	if status == domain.JobStatusUnknown {
+		return nil, domain.ErrInvalidRequest
+	}
+	job, err := s.repository.GetJobById(id.String(), ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to get job to update status", err)
+		return nil, domain.ErrJobNotFound
+	}
+	if request.Version != 0 && request.Version != job.Version {
+		return nil, domain.ErrVersionConflict
+	}
+	if !job.ChangeStatus(status) {
+		return job, nil
+	}
+	err = s.repository.UpdateJob(job, ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to update job status", err)
+		return nil, err
+	}
+	s.log.Info(ctx, "job status updated",
+		domain.Field{Key: "job_id", Value: job.Id.String()},
+		domain.Field{Key: "status", Value: string(job.Status)},
+	)
+	return job, nil
+}
+
+func (s *JobService) DeleteJob(id uuid.UUID, ctx context.Context) (err error) {
+	ctx, span := s.tracer.Start(ctx, "JobService.DeleteJob", trace.WithAttributes(jobIdAttribute(id.String())))
+	defer func() { EndSpan(span, err) }()
+
 	s.log.Info(ctx, "deleting job", domain.Field{Key: "job_id", Value: id.String()})
-	err := s.repository.DeleteJob(id.String())
+	err = s.repository.DeleteJob(id.String(), ctx)
 	if err != nil {
 		s.log.Error(ctx, "failed to delete job", err)
 		return err
@@ -59,8 +147,11 @@ func (s *JobService) DeleteJob(id uuid.UUID, ctx context.Context) error {
 	return nil
 }
 
-func (s *JobService) GetAllJobs(ctx context.Context) ([]*domain.Job, error) {
-	jobs, err := s.repository.GetAll()
+func (s *JobService) GetAllJobs(includeArchived bool, ctx context.Context) (_ []*domain.Job, err error) {
+	ctx, span := s.tracer.Start(ctx, "JobService.GetAllJobs", trace.WithAttributes(attribute.Bool("job.include_archived", includeArchived)))
+	defer func() { EndSpan(span, err) }()
+
+	jobs, err := s.repository.GetAll(includeArchived, ctx)
 	if err != nil {
 		s.log.Error(ctx, "failed to get all jobs", err)
 		return nil, err
@@ -68,8 +159,11 @@ func (s *JobService) GetAllJobs(ctx context.Context) ([]*domain.Job, error) {
 	return jobs, nil
 }
 
-func (s *JobService) GetJob(id uuid.UUID, ctx context.Context) (*domain.Job, error) {
-	job, err := s.repository.GetJobById(id.String())
+func (s *JobService) GetJob(id uuid.UUID, ctx context.Context) (_ *domain.Job, err error) {
+	ctx, span := s.tracer.Start(ctx, "JobService.GetJob", trace.WithAttributes(jobIdAttribute(id.String())))
+	defer func() { EndSpan(span, err) }()
+
+	job, err := s.repository.GetJobById(id.String(), ctx)
 	if err != nil {
 		s.log.Error(ctx, "failed to get job", err)
 		return nil, domain.ErrJobNotFound
@@ -77,8 +171,30 @@ func (s *JobService) GetJob(id uuid.UUID, ctx context.Context) (*domain.Job, err
 	return job, nil
 }
 
-func (s *JobService) GetJobsByStatus(status domain.JobStatus, ctx context.Context) ([]*domain.Job, error) {
-	jobs, err := s.repository.GetJobsByStatus(status)
+func (s *JobService) GetJobsByIds(ids []uuid.UUID, ctx context.Context) (_ []*domain.Job, err error) {
+	ctx, span := s.tracer.Start(ctx, "JobService.GetJobsByIds", trace.WithAttributes(attribute.Int("job.count", len(ids))))
+	defer func() { EndSpan(span, err) }()
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = id.String()
+	}
+	jobs, err := s.repository.GetJobsByIds(keys, ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to get jobs by ids", err)
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (s *JobService) GetJobsByStatus(status domain.JobStatus, includeArchived bool, ctx context.Context) (_ []*domain.Job, err error) {
+	ctx, span := s.tracer.Start(ctx, "JobService.GetJobsByStatus", trace.WithAttributes(
+		attribute.String("job.status", string(status)),
+		attribute.Bool("job.include_archived", includeArchived),
+	))
+	defer func() { EndSpan(span, err) }()
+
+	jobs, err := s.repository.GetJobsByStatus(status, includeArchived, ctx)
 	if err != nil {
 		s.log.Error(ctx, "failed to get jobs by status", err)
 		return nil, err
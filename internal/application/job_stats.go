@@ -0,0 +1,82 @@
+package application
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const statsWeeks = 8
+
+type StatusCount struct {
+	Status domain.JobStatus
+	Count  int
+}
+
+type WeekCount struct {
+	Start time.Time
+	Count int
+}
+
+// JobStats summarises the jobs that are not in the trash. Archived jobs are
+// only counted when asked for, like every other analytics view.
+type JobStats struct {
+	Total         int
+	Archived      int
+	Remote        int
+	AverageSalary int
+	ByStatus      []StatusCount
+	// Weekly counts the jobs added in each of the last weeks, oldest first.
+	Weekly []WeekCount
+}
+
+func (s *JobService) Stats(includeArchived bool, now time.Time, ctx context.Context) (_ *JobStats, err error) {
+	ctx, span := s.tracer.Start(ctx, "JobService.Stats", trace.WithAttributes(attribute.Bool("job.include_archived", includeArchived)))
+	defer func() { EndSpan(span, err) }()
+
+	jobs, err := s.repository.GetAll(includeArchived, ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to get jobs for stats", err)
+		return nil, err
+	}
+
+	stats := &JobStats{Total: len(jobs)}
+	byStatus := make(map[domain.JobStatus]int)
+	salaries, salaried := 0, 0
+
+	year, month, day := now.Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, now.Location())
+	monday := today.AddDate(0, 0, -(int(today.Weekday())+6)%7)
+	firstWeek := monday.AddDate(0, 0, -7*(statsWeeks-1))
+	stats.Weekly = make([]WeekCount, statsWeeks)
+	for i := range stats.Weekly {
+		stats.Weekly[i].Start = firstWeek.AddDate(0, 0, 7*i)
+	}
+
+	for _, job := range jobs {
+		byStatus[job.Status]++
+		if job.Archived {
+			stats.Archived++
+		}
+		if job.Remote {
+			stats.Remote++
+		}
+		if job.Salary > 0 {
+			salaries += job.Salary
+			salaried++
+		}
+		if week := int(job.CreatedAt.Sub(firstWeek).Hours() / (24 * 7)); !job.CreatedAt.Before(firstWeek) && week < statsWeeks {
+			stats.Weekly[week].Count++
+		}
+	}
+	if salaried > 0 {
+		stats.AverageSalary = salaries / salaried
+	}
+	for _, status := range domain.JobStatuses {
+		stats.ByStatus = append(stats.ByStatus, StatusCount{Status: status, Count: byStatus[status]})
+	}
+	return stats, nil
+}
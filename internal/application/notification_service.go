@@ -0,0 +1,219 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"job-tracker/internal/domain"
+	"time"
+)
+
+type NotificationService struct {
+	repository  domain.NotificationRepository
+	senders     map[domain.ChannelKind]domain.NotificationSender
+	maxAttempts int
+	backoff     time.Duration
+	batchSize   int
+	log         domain.Logger
+}
+
+func NewNotificationService(repository domain.NotificationRepository, senders []domain.NotificationSender, log domain.Logger) *NotificationService {
+	bySender := make(map[domain.ChannelKind]domain.NotificationSender, len(senders))
+	for _, sender := range senders {
+		bySender[sender.Kind()] = sender
+	}
+	return &NotificationService{
+		repository:  repository,
+		senders:     bySender,
+		maxAttempts: 3,
+		backoff:     30 * time.Second,
+		batchSize:   50,
+		log:         log,
+	}
+}
+
+func (s *NotificationService) Name() string {
+	return "notifications"
+}
+
+// Handle notifies status changes. Deliveries are only queued here, so a
+// channel that is down does not hold up the relay.
+func (s *NotificationService) Handle(ctx context.Context, event domain.Event) error {
+	if event.Type != domain.JobStatusChanged || event.Job == nil {
+		return nil
+	}
+	notification := domain.Notification{
+		Event:   domain.NotificationStatusChanged,
+		Subject: "Status changed: " + event.Job.Position + " at " + event.Job.Company,
+		Message: string(event.PreviousStatus) + " -> " + string(event.Job.Status),
+		JobId:   event.JobId,
+		UserId:  event.Job.UserId,
+	}
+	if err := s.Notify(ctx, notification); err != nil {
+		s.log.Error(ctx, "failed to notify status change", err)
+	}
+	return nil
+}
+
+// Notify queues a delivery for each of the job owner's channels subscribed to
+// its event; DispatchDue sends them. Jobs without an owner notify nobody. It
+// only fails when no delivery could be queued, so callers do not queue the
+// notification twice.
+func (s *NotificationService) Notify(ctx context.Context, notification domain.Notification) error {
+	s.log.Info(ctx, notification.Subject,
+		domain.Field{Key: "event", Value: string(notification.Event)},
+		domain.Field{Key: "message", Value: notification.Message},
+		domain.Field{Key: "job_id", Value: notification.JobId.String()},
+		domain.Field{Key: "user_id", Value: notification.UserId},
+	)
+
+	channels, err := s.repository.GetEnabledChannels(notification.UserId, ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to get notification channels", err)
+		return err
+	}
+
+	var errs []error
+	queued := 0
+	for _, channel := range channels {
+		if !channel.Subscribes(notification.Event) {
+			continue
+		}
+		if err := s.repository.CreateDelivery(domain.NewNotificationDelivery(channel.Id, notification), ctx); err != nil {
+			s.log.Error(ctx, "failed to queue notification delivery", err, domain.Field{Key: "channel_id", Value: channel.Id.String()})
+			errs = append(errs, err)
+			continue
+		}
+		queued++
+	}
+
+	if queued == 0 && len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
+// DispatchDue sends the deliveries that are due and schedules a retry for the
+// ones that fail.
+func (s *NotificationService) DispatchDue(now time.Time, ctx context.Context) error {
+	deliveries, err := s.repository.GetDueDeliveries(now, s.batchSize, ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to get due notification deliveries", err)
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		s.send(delivery, now, ctx)
+		if err := s.repository.UpdateDelivery(delivery, ctx); err != nil {
+			s.log.Error(ctx, "failed to update notification delivery", err, domain.Field{Key: "delivery_id", Value: delivery.Id.String()})
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *NotificationService) send(delivery *domain.NotificationDelivery, now time.Time, ctx context.Context) {
+	channel, err := s.repository.GetChannelById(delivery.ChannelId.String(), ctx)
+	if err != nil {
+		delivery.Failed(domain.ErrChannelNotFound, now, 0, s.backoff)
+		return
+	}
+	if !channel.Enabled {
+		delivery.Failed(errors.New("channel is disabled"), now, 0, s.backoff)
+		return
+	}
+	sender, ok := s.senders[channel.Kind]
+	if !ok {
+		delivery.Failed(errors.New("unsupported channel kind "+string(channel.Kind)), now, 0, s.backoff)
+		return
+	}
+
+	if err := sender.Send(ctx, channel, delivery.Notification()); err != nil {
+		delivery.Failed(err, now, s.maxAttempts, s.backoff)
+		s.log.Error(ctx, "notification delivery failed", err,
+			domain.Field{Key: "delivery_id", Value: delivery.Id.String()},
+			domain.Field{Key: "channel_id", Value: channel.Id.String()},
+			domain.Field{Key: "attempts", Value: delivery.Attempts},
+			domain.Field{Key: "status", Value: string(delivery.Status)},
+		)
+		return
+	}
+	delivery.Delivered(now)
+}
+
+func (s *NotificationService) CreateChannel(userId string, request *CreateChannelRequest, ctx context.Context) (*domain.NotificationChannel, error) {
+	s.log.Info(ctx, "creating notification channel", domain.Field{Key: "user_id", Value: userId})
+	channel := domain.NewNotificationChannel(userId, domain.ChannelKind(request.Kind), request.Target, request.Secret, toNotificationEvents(request.Events))
+	if err := s.repository.CreateChannel(channel, ctx); err != nil {
+		s.log.Error(ctx, "failed to create notification channel", err)
+		return nil, err
+	}
+	s.log.Info(ctx, "notification channel created", domain.Field{Key: "channel_id", Value: channel.Id.String()})
+	return channel, nil
+}
+
+func (s *NotificationService) UpdateChannel(userId string, id string, request *UpdateChannelRequest, ctx context.Context) (*domain.NotificationChannel, error) {
+	s.log.Info(ctx, "updating notification channel", domain.Field{Key: "channel_id", Value: id})
+	channel, err := s.getUserChannel(userId, id, ctx)
+	if err != nil {
+		return nil, err
+	}
+	channel.Update(toNotificationEvents(request.Events), request.Enabled)
+	if err := s.repository.UpdateChannel(channel, ctx); err != nil {
+		s.log.Error(ctx, "failed to update notification channel", err)
+		return nil, err
+	}
+	return channel, nil
+}
+
+func (s *NotificationService) GetChannels(userId string, ctx context.Context) ([]*domain.NotificationChannel, error) {
+	channels, err := s.repository.GetChannelsByUser(userId, ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to get notification channels", err)
+		return nil, err
+	}
+	return channels, nil
+}
+
+func (s *NotificationService) DeleteChannel(userId string, id string, ctx context.Context) error {
+	s.log.Info(ctx, "deleting notification channel", domain.Field{Key: "channel_id", Value: id})
+	if _, err := s.getUserChannel(userId, id, ctx); err != nil {
+		return err
+	}
+	if err := s.repository.DeleteChannel(id, ctx); err != nil {
+		s.log.Error(ctx, "failed to delete notification channel", err)
+		return err
+	}
+	return nil
+}
+
+func (s *NotificationService) GetDeliveries(userId string, id string, ctx context.Context) ([]*domain.NotificationDelivery, error) {
+	if _, err := s.getUserChannel(userId, id, ctx); err != nil {
+		return nil, err
+	}
+	deliveries, err := s.repository.GetDeliveriesByChannel(id, ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to get notification deliveries", err)
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (s *NotificationService) getUserChannel(userId string, id string, ctx context.Context) (*domain.NotificationChannel, error) {
+	channel, err := s.repository.GetChannelById(id, ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to get notification channel", err)
+		return nil, domain.ErrChannelNotFound
+	}
+	if channel.UserId != userId {
+		return nil, domain.ErrChannelNotFound
+	}
+	return channel, nil
+}
+
+func toNotificationEvents(events []string) []domain.NotificationEvent {
+	result := make([]domain.NotificationEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, domain.NotificationEvent(event))
+	}
+	return result
+}
@@ -0,0 +1,160 @@
+package application
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReminderService struct {
+	repository domain.ReminderRepository
+	jobs       domain.JobRepository
+	notifier   domain.Notifier
+	rules      []domain.ReminderRule
+	log        domain.Logger
+}
+
+func NewReminderService(repository domain.ReminderRepository, jobs domain.JobRepository, notifier domain.Notifier, log domain.Logger) *ReminderService {
+	return &ReminderService{
+		repository: repository,
+		jobs:       jobs,
+		notifier:   notifier,
+		rules:      domain.DefaultReminderRules,
+		log:        log,
+	}
+}
+
+func (s *ReminderService) CreateReminder(request *CreateReminderRequest, ctx context.Context) (*domain.Reminder, error) {
+	s.log.Info(ctx, "creating reminder", domain.Field{Key: "job_id", Value: request.JobId.String()})
+	if _, err := s.jobs.GetJobById(request.JobId.String(), ctx); err != nil {
+		s.log.Error(ctx, "failed to get job for reminder", err)
+		return nil, domain.ErrJobNotFound
+	}
+	reminder := domain.NewReminder(request.JobId, request.DueAt, request.Message, domain.ReminderRecurrenceFromString(request.Recurrence))
+	if err := s.repository.CreateReminder(reminder, ctx); err != nil {
+		s.log.Error(ctx, "failed to create reminder", err)
+		return nil, err
+	}
+	s.log.Info(ctx, "reminder created", domain.Field{Key: "reminder_id", Value: reminder.Id.String()})
+	return reminder, nil
+}
+
+func (s *ReminderService) Name() string {
+	return "reminders"
+}
+
+func (s *ReminderService) Handle(ctx context.Context, event domain.Event) error {
+	switch {
+	case event.Type == domain.JobStatusChanged && event.Job != nil:
+		return s.ScheduleForStatus(event.Job, event.Id, ctx)
+	case event.Type == domain.JobArchived:
+		return s.completeRuleReminders(event.JobId, uuid.Nil, ctx)
+	default:
+		return nil
+	}
+}
+
+// ScheduleForStatus completes the reminders the rules scheduled for the job's
+// previous statuses and creates the ones they attach to its new status.
+// Reminders already scheduled for eventId are left as they are.
+func (s *ReminderService) ScheduleForStatus(job *domain.Job, eventId uuid.UUID, ctx context.Context) error {
+	if err := s.completeRuleReminders(job.Id, eventId, ctx); err != nil {
+		return err
+	}
+	for _, rule := range s.rules {
+		if rule.Status != job.Status {
+			continue
+		}
+		reminder := domain.NewReminder(job.Id, time.Now().Add(rule.After), rule.Message, domain.ReminderRecurrenceNone)
+		reminder.EventId = &eventId
+		if err := s.repository.CreateReminder(reminder, ctx); err != nil {
+			s.log.Error(ctx, "failed to schedule reminder", err, domain.Field{Key: "job_id", Value: job.Id.String()})
+			return err
+		}
+		s.log.Info(ctx, "reminder scheduled",
+			domain.Field{Key: "job_id", Value: job.Id.String()},
+			domain.Field{Key: "reminder_id", Value: reminder.Id.String()},
+		)
+	}
+	return nil
+}
+
+func (s *ReminderService) completeRuleReminders(jobId uuid.UUID, keep uuid.UUID, ctx context.Context) error {
+	if err := s.repository.CompleteRuleReminders(jobId.String(), keep, ctx); err != nil {
+		s.log.Error(ctx, "failed to complete rule reminders", err, domain.Field{Key: "job_id", Value: jobId.String()})
+		return err
+	}
+	return nil
+}
+
+func (s *ReminderService) GetReminders(due string, ctx context.Context) ([]*domain.Reminder, error) {
+	var reminders []*domain.Reminder
+	var err error
+	switch due {
+	case "":
+		reminders, err = s.repository.GetPending(ctx)
+	case "today":
+		reminders, err = s.repository.GetDueBefore(endOfDay(time.Now()), ctx)
+	case "overdue":
+		reminders, err = s.repository.GetDueBefore(time.Now(), ctx)
+	default:
+		return nil, domain.ErrInvalidRequest
+	}
+	if err != nil {
+		s.log.Error(ctx, "failed to get reminders", err)
+		return nil, err
+	}
+	return reminders, nil
+}
+
+func (s *ReminderService) GetRemindersByJobIds(jobIds []uuid.UUID, ctx context.Context) ([]*domain.Reminder, error) {
+	keys := make([]string, len(jobIds))
+	for i, id := range jobIds {
+		keys[i] = id.String()
+	}
+	reminders, err := s.repository.GetByJobIds(keys, ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to get reminders by job", err)
+		return nil, err
+	}
+	return reminders, nil
+}
+
+func (s *ReminderService) FireDue(now time.Time, ctx context.Context) error {
+	reminders, err := s.repository.GetDueBefore(now, ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to get due reminders", err)
+		return err
+	}
+	for _, reminder := range reminders {
+		job, err := s.jobs.GetJobById(reminder.JobId.String(), ctx)
+		if err != nil {
+			s.log.Debug(ctx, "skipping reminder for missing or deleted job", domain.Field{Key: "reminder_id", Value: reminder.Id.String()})
+			continue
+		}
+		notification := domain.Notification{
+			Event:   domain.NotificationReminderDue,
+			Subject: "Reminder: " + job.Position + " at " + job.Company,
+			Message: reminder.Message,
+			JobId:   reminder.JobId,
+			UserId:  job.UserId,
+		}
+		if err := s.notifier.Notify(ctx, notification); err != nil {
+			s.log.Error(ctx, "failed to notify reminder", err, domain.Field{Key: "reminder_id", Value: reminder.Id.String()})
+			continue
+		}
+		reminder.Fire(now)
+		if err := s.repository.UpdateReminder(reminder, ctx); err != nil {
+			s.log.Error(ctx, "failed to update reminder", err, domain.Field{Key: "reminder_id", Value: reminder.Id.String()})
+			return err
+		}
+	}
+	return nil
+}
+
+func endOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day+1, 0, 0, 0, 0, t.Location())
+}
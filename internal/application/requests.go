@@ -1,6 +1,10 @@
 package application
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type CreateJobRequest struct {
 	Company     string `json:"company" binding:"required,min=2"`
@@ -9,6 +13,7 @@ type CreateJobRequest struct {
 	Salary      int    `json:"salary"`
 	Remote      bool   `json:"remote"`
 	Url         string `json:"url"`
+	UserId      string `json:"userId"`
 }
 
 type UpdateJobRequest struct {
@@ -19,4 +24,93 @@ type UpdateJobRequest struct {
 	Salary      int       `json:"salary"`
 	Remote      bool      `json:"remote"`
 	Url         string    `json:"url"`
+	Version     int       `json:"version"`
+}
+
+type UpdateJobStatusRequest struct {
+	Status  string `json:"status" binding:"required"`
+	Version int    `json:"version"`
+}
+
+type ArchiveJobsRequest struct {
+	Ids []uuid.UUID `json:"ids" binding:"required,min=1,max=100"`
+}
+
+type BulkMode string
+
+const (
+	BulkModeAtomic     BulkMode = "atomic"
+	BulkModeBestEffort BulkMode = "best-effort"
+)
+
+type BulkOperationKind string
+
+const (
+	BulkOperationCreate BulkOperationKind = "create"
+	BulkOperationUpdate BulkOperationKind = "update"
+	BulkOperationStatus BulkOperationKind = "status"
+	BulkOperationDelete BulkOperationKind = "delete"
+	BulkOperationTag    BulkOperationKind = "tag"
+)
+
+type BulkJobsRequest struct {
+	Mode       BulkMode        `json:"mode" binding:"omitempty,oneof=atomic best-effort"`
+	Operations []BulkOperation `json:"operations" binding:"required,min=1,max=100,dive"`
+}
+
+// BulkOperation is one item of a bulk request. Which fields are used depends
+// on Op; they are validated per item so one bad item does not reject the batch.
+type BulkOperation struct {
+	Op          BulkOperationKind `json:"op" binding:"required,oneof=create update status delete tag"`
+	Id          uuid.UUID         `json:"id"`
+	Version     int               `json:"version"`
+	Company     string            `json:"company"`
+	Position    string            `json:"position"`
+	Description string            `json:"description"`
+	Salary      int               `json:"salary"`
+	Remote      bool              `json:"remote"`
+	Url         string            `json:"url"`
+	Status      string            `json:"status"`
+	AddTags     []string          `json:"addTags"`
+	RemoveTags  []string          `json:"removeTags"`
+}
+
+type CreateReminderRequest struct {
+	JobId      uuid.UUID `json:"jobId" binding:"required"`
+	DueAt      time.Time `json:"dueAt" binding:"required"`
+	Message    string    `json:"message" binding:"required,min=2"`
+	Recurrence string    `json:"recurrence" binding:"omitempty,oneof=NONE DAILY WEEKLY MONTHLY"`
+}
+
+type CreateChannelRequest struct {
+	Kind   string   `json:"kind" binding:"required,oneof=EMAIL SLACK WEBHOOK"`
+	Target string   `json:"target" binding:"required"`
+	Secret string   `json:"secret"`
+	Events []string `json:"events" binding:"required,min=1,dive,oneof=STATUS_CHANGED POSTING_CLOSED REMINDER_DUE SCRAPE_FAILED"`
+}
+
+type UpdateChannelRequest struct {
+	Events  []string `json:"events" binding:"required,min=1,dive,oneof=STATUS_CHANGED POSTING_CLOSED REMINDER_DUE SCRAPE_FAILED"`
+	Enabled bool     `json:"enabled"`
+}
+
+type CreateWebhookRequest struct {
+	Url    string   `json:"url" binding:"required,url"`
+	Secret string   `json:"secret" binding:"required,min=16"`
+	Events []string `json:"events" binding:"dive,oneof=job.created job.updated job.status_changed job.deleted job.restored job.archived job.unarchived"`
+}
+
+type PatchFormat string
+
+const (
+	PatchFormatMerge PatchFormat = "merge-patch"
+	PatchFormatJSON  PatchFormat = "json-patch"
+)
+
+// PatchJobRequest carries an RFC 7396 merge patch or an RFC 6902 JSON patch
+// to apply to the editable fields of a job.
+type PatchJobRequest struct {
+	Format  PatchFormat
+	Patch   []byte
+	Version int
 }
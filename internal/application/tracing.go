@@ -0,0 +1,29 @@
+package application
+
+import (
+	"errors"
+	"job-tracker/internal/domain"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "job-tracker/application"
+
+func jobIdAttribute(id string) attribute.KeyValue {
+	return attribute.String("job.id", id)
+}
+
+// EndSpan records err on the span and ends it. Errors the caller caused, such
+// as a missing job or a stale version, are recorded without failing the span;
+// any other error fails it. Every layer ends its spans through this rule.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		if !errors.Is(err, domain.ErrJobNotFound) && !errors.Is(err, domain.ErrVersionConflict) && !errors.Is(err, domain.ErrInvalidRequest) {
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+	span.End()
+}
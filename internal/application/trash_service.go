@@ -0,0 +1,58 @@
+package application
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrashRetention is how long deleted jobs stay restorable before they are purged.
+type TrashRetention time.Duration
+
+type TrashService struct {
+	repository domain.JobRepository
+	retention  time.Duration
+	log        domain.Logger
+}
+
+func NewTrashService(repository domain.JobRepository, retention TrashRetention, log domain.Logger) *TrashService {
+	return &TrashService{
+		repository: repository,
+		retention:  time.Duration(retention),
+		log:        log,
+	}
+}
+
+func (s *TrashService) GetTrash(ctx context.Context) ([]*domain.Job, error) {
+	jobs, err := s.repository.GetDeleted(ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to get deleted jobs", err)
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (s *TrashService) RestoreJob(id uuid.UUID, ctx context.Context) (*domain.Job, error) {
+	s.log.Info(ctx, "restoring job", domain.Field{Key: "job_id", Value: id.String()})
+	job, err := s.repository.RestoreJob(id.String(), ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to restore job", err)
+		return nil, err
+	}
+	s.log.Info(ctx, "job restored", domain.Field{Key: "job_id", Value: id.String()})
+	return job, nil
+}
+
+func (s *TrashService) Purge(now time.Time, ctx context.Context) error {
+	purged, err := s.repository.PurgeDeleted(now.Add(-s.retention), ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to purge deleted jobs", err)
+		return err
+	}
+	if purged > 0 {
+		s.log.Info(ctx, "purged deleted jobs", domain.Field{Key: "count", Value: purged})
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"job-tracker/internal/domain"
+	"time"
+)
+
+type WebhookService struct {
+	repository  domain.WebhookRepository
+	client      domain.WebhookClient
+	maxAttempts int
+	backoff     time.Duration
+	batchSize   int
+	log         domain.Logger
+}
+
+func NewWebhookService(repository domain.WebhookRepository, client domain.WebhookClient, log domain.Logger) *WebhookService {
+	return &WebhookService{
+		repository:  repository,
+		client:      client,
+		maxAttempts: 6,
+		backoff:     30 * time.Second,
+		batchSize:   50,
+		log:         log,
+	}
+}
+
+func (s *WebhookService) Name() string {
+	return "webhooks"
+}
+
+func (s *WebhookService) Handle(ctx context.Context, event domain.Event) error {
+	subscriptions, err := s.repository.GetSubscriptions(ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to get webhook subscriptions", err)
+		return err
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	for _, subscription := range subscriptions {
+		if !subscription.Matches(event.Type) {
+			continue
+		}
+		delivery := domain.NewWebhookDelivery(subscription.Id, event, string(payload))
+		if err := s.repository.CreateDelivery(delivery, ctx); err != nil {
+			s.log.Error(ctx, "failed to enqueue webhook delivery", err, domain.Field{Key: "webhook_id", Value: subscription.Id.String()})
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *WebhookService) DispatchDue(now time.Time, ctx context.Context) error {
+	deliveries, err := s.repository.GetDueDeliveries(now, s.batchSize, ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to get due webhook deliveries", err)
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		subscription, err := s.repository.GetSubscriptionById(delivery.SubscriptionId.String(), ctx)
+		if err != nil {
+			delivery.Failed(0, domain.ErrWebhookNotFound, now, 0, s.backoff)
+		} else {
+			s.send(subscription, delivery, now, ctx)
+		}
+		if err := s.repository.UpdateDelivery(delivery, ctx); err != nil {
+			s.log.Error(ctx, "failed to update webhook delivery", err, domain.Field{Key: "delivery_id", Value: delivery.Id.String()})
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *WebhookService) send(subscription *domain.WebhookSubscription, delivery *domain.WebhookDelivery, now time.Time, ctx context.Context) {
+	status, err := s.client.Post(ctx, subscription, delivery)
+	if err == nil && (status < 200 || status >= 300) {
+		err = fmt.Errorf("webhook responded with status %d", status)
+	}
+	if err != nil {
+		delivery.Failed(status, err, now, s.maxAttempts, s.backoff)
+		s.log.Error(ctx, "webhook delivery failed", err,
+			domain.Field{Key: "delivery_id", Value: delivery.Id.String()},
+			domain.Field{Key: "attempts", Value: delivery.Attempts},
+			domain.Field{Key: "status", Value: string(delivery.Status)},
+		)
+		return
+	}
+	delivery.Succeeded(status, now)
+}
+
+func (s *WebhookService) CreateSubscription(request *CreateWebhookRequest, ctx context.Context) (*domain.WebhookSubscription, error) {
+	s.log.Info(ctx, "creating webhook subscription")
+	events := make([]domain.EventType, 0, len(request.Events))
+	for _, event := range request.Events {
+		events = append(events, domain.EventType(event))
+	}
+	subscription := domain.NewWebhookSubscription(request.Url, request.Secret, events)
+	if err := s.repository.CreateSubscription(subscription, ctx); err != nil {
+		s.log.Error(ctx, "failed to create webhook subscription", err)
+		return nil, err
+	}
+	s.log.Info(ctx, "webhook subscription created", domain.Field{Key: "webhook_id", Value: subscription.Id.String()})
+	return subscription, nil
+}
+
+func (s *WebhookService) GetSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	subscriptions, err := s.repository.GetSubscriptions(ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to get webhook subscriptions", err)
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+func (s *WebhookService) DeleteSubscription(id string, ctx context.Context) error {
+	s.log.Info(ctx, "deleting webhook subscription", domain.Field{Key: "webhook_id", Value: id})
+	if err := s.repository.DeleteSubscription(id, ctx); err != nil {
+		s.log.Error(ctx, "failed to delete webhook subscription", err)
+		return err
+	}
+	return nil
+}
+
+func (s *WebhookService) GetDeliveries(subscriptionId string, ctx context.Context) ([]*domain.WebhookDelivery, error) {
+	if _, err := s.repository.GetSubscriptionById(subscriptionId, ctx); err != nil {
+		s.log.Error(ctx, "failed to get webhook subscription", err)
+		return nil, domain.ErrWebhookNotFound
+	}
+	deliveries, err := s.repository.GetDeliveriesBySubscription(subscriptionId, ctx)
+	if err != nil {
+		s.log.Error(ctx, "failed to get webhook deliveries", err)
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (s *WebhookService) ReplayDelivery(subscriptionId string, deliveryId string, ctx context.Context) (*domain.WebhookDelivery, error) {
+	s.log.Info(ctx, "replaying webhook delivery", domain.Field{Key: "delivery_id", Value: deliveryId})
+	delivery, err := s.repository.GetDeliveryById(deliveryId, ctx)
+	if err != nil || delivery.SubscriptionId.String() != subscriptionId {
+		return nil, domain.ErrDeliveryNotFound
+	}
+	delivery.Replay()
+	if err := s.repository.UpdateDelivery(delivery, ctx); err != nil {
+		s.log.Error(ctx, "failed to replay webhook delivery", err)
+		return nil, err
+	}
+	return delivery, nil
+}
@@ -7,6 +7,7 @@ import (
 	"job-tracker/internal/domain"
 	"job-tracker/internal/infrastructure"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -15,88 +16,134 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/spf13/pflag"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
 type App struct {
-	Logger      domain.Logger
-	JobHandler  *infrastructure.JobHandler
-	JobScrapper *infrastructure.JobScrapper
+	Logger                 domain.Logger
+	JobHandler             *infrastructure.JobHandler
+	JobScrapper            *infrastructure.JobScrapper
+	ReminderHandler        *infrastructure.ReminderHandler
+	ReminderScheduler      *infrastructure.ReminderScheduler
+	NotificationHandler    *infrastructure.NotificationHandler
+	NotificationDispatcher *infrastructure.NotificationDispatcher
+	WebhookHandler         *infrastructure.WebhookHandler
+	WebhookDispatcher      *infrastructure.WebhookDispatcher
+	OutboxPoller           *infrastructure.OutboxPoller
+	EventStreamHandler     *infrastructure.EventStreamHandler
+	TrashHandler           *infrastructure.TrashHandler
+	TrashPurger            *infrastructure.TrashPurger
+	ArchiveHandler         *infrastructure.ArchiveHandler
+	JobArchiver            *infrastructure.JobArchiver
+	DocsHandler            *infrastructure.DocsHandler
+	GraphQLHandler         *infrastructure.GraphQLHandler
+	WebHandler             *infrastructure.WebHandler
+	JobGrpcServer          *infrastructure.JobGrpcServer
 }
 
-func NewApp(logger domain.Logger, jobHandler *infrastructure.JobHandler, jobScrapper *infrastructure.JobScrapper) *App {
+func NewApp(
+	logger domain.Logger,
+	jobHandler *infrastructure.JobHandler,
+	jobScrapper *infrastructure.JobScrapper,
+	reminderHandler *infrastructure.ReminderHandler,
+	reminderScheduler *infrastructure.ReminderScheduler,
+	notificationHandler *infrastructure.NotificationHandler,
+	notificationDispatcher *infrastructure.NotificationDispatcher,
+	webhookHandler *infrastructure.WebhookHandler,
+	webhookDispatcher *infrastructure.WebhookDispatcher,
+	outboxPoller *infrastructure.OutboxPoller,
+	eventStreamHandler *infrastructure.EventStreamHandler,
+	trashHandler *infrastructure.TrashHandler,
+	trashPurger *infrastructure.TrashPurger,
+	archiveHandler *infrastructure.ArchiveHandler,
+	jobArchiver *infrastructure.JobArchiver,
+	docsHandler *infrastructure.DocsHandler,
+	graphQLHandler *infrastructure.GraphQLHandler,
+	webHandler *infrastructure.WebHandler,
+	jobGrpcServer *infrastructure.JobGrpcServer,
+) *App {
 	return &App{
-		Logger:      logger,
-		JobHandler:  jobHandler,
-		JobScrapper: jobScrapper,
+		Logger:                 logger,
+		JobHandler:             jobHandler,
+		JobScrapper:            jobScrapper,
+		ReminderHandler:        reminderHandler,
+		ReminderScheduler:      reminderScheduler,
+		NotificationHandler:    notificationHandler,
+		NotificationDispatcher: notificationDispatcher,
+		WebhookHandler:         webhookHandler,
+		WebhookDispatcher:      webhookDispatcher,
+		OutboxPoller:           outboxPoller,
+		EventStreamHandler:     eventStreamHandler,
+		TrashHandler:           trashHandler,
+		TrashPurger:            trashPurger,
+		ArchiveHandler:         archiveHandler,
+		JobArchiver:            jobArchiver,
+		DocsHandler:            docsHandler,
+		GraphQLHandler:         graphQLHandler,
+		WebHandler:             webHandler,
+		JobGrpcServer:          jobGrpcServer,
 	}
 }
 
-func Start() error {
+// RegisterAPIRoutes mounts every API handler under /api/v1, the routes
+// openapi.json documents.
+func RegisterAPIRoutes(r gin.IRouter, app *App) {
+	v1 := r.Group("/api/v1")
+	app.JobHandler.RegisterRoutes(v1)
+	app.ReminderHandler.RegisterRoutes(v1)
+	app.NotificationHandler.RegisterRoutes(v1)
+	app.WebhookHandler.RegisterRoutes(v1)
+	app.EventStreamHandler.RegisterRoutes(v1)
+	app.TrashHandler.RegisterRoutes(v1)
+	app.ArchiveHandler.RegisterRoutes(v1)
+	app.DocsHandler.RegisterRoutes(v1)
+	app.GraphQLHandler.RegisterRoutes(v1)
+}
+
+func Start(flags *pflag.FlagSet) error {
 
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
-	config, err := LoadConfig()
+	config, err := LoadConfig(flags)
 	if err != nil {
 		return err
 	}
 
-	logger, lp, err := InitLogs(config)
-	if err != nil {
-		log.Println(err)
-		return err
-	}
+	telemetry := InitTelemetry(config)
 	defer func() {
-		if err := lp.Shutdown(context.Background()); err != nil {
-			log.Fatal(err)
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := telemetry.Shutdown(shutdownCtx); err != nil {
+			log.Println(err)
 		}
 	}()
+	logger, tracer, metrics := telemetry.Logger, telemetry.TracerProvider, telemetry.MeterProvider
 
-	tracer, tp, err := InitTracer()
+	db, err := InitDatabase(config)
 	if err != nil {
-		log.Println(err)
 		return err
 	}
-	defer func() {
-		if err := tp.Shutdown(context.Background()); err != nil {
-			log.Fatal(err)
-		}
-	}()
-
-	metrics, err := InitMetrics()
-	if err != nil {
-		log.Println(err)
+	if err := db.Use(infrastructure.NewGormTracing(tracer)); err != nil {
 		return err
 	}
-	defer func() {
-		if err := metrics.Shutdown(context.Background()); err != nil {
-			log.Println(err)
-		}
-	}()
 
-	profile, err := InitProfile(config)
+	migrator, err := infrastructure.NewMigrator(db)
 	if err != nil {
-		log.Println(err)
 		return err
 	}
-	defer func() {
-		if err := profile.Stop(); err != nil {
-			log.Println(err)
-		}
-	}()
-
-	db, err := InitDatabase(config)
-	if err != nil {
+	// Replicas booting together take turns; a dirty schema stops the boot.
+	if err := migrator.Up(ctx); err != nil {
 		return err
 	}
 
-	err = db.AutoMigrate(&domain.Job{})
+	cache, err := InitCache(config)
 	if err != nil {
 		return err
 	}
 
-	app := InitApp(logger, db)
+	app := InitApp(config, logger, db, cache, metrics, tracer)
 
 	r := gin.New()
 	r.Use(gin.Recovery())
@@ -108,13 +155,23 @@ func Start() error {
 			otelgin.WithTracerProvider(tracer),
 		),
 	)
-	app.JobHandler.RegisterRoutes(r)
-	RegisterStatus(r)
+	RegisterAPIRoutes(r, app)
+	app.WebHandler.RegisterRoutes(r)
+	RegisterStatus(r, telemetry)
 
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%d", config.Port),
 		Handler: r,
 	}
+	// Shutdown waits for handlers to return, which streams only do when told.
+	srv.RegisterOnShutdown(app.EventStreamHandler.CloseStreams)
+	srv.RegisterOnShutdown(app.GraphQLHandler.CloseStreams)
+
+	grpcServer := InitGrpcServer(app, tracer, metrics)
+	grpcListener, err := net.Listen("tcp", fmt.Sprintf(":%d", config.GRPCPort))
+	if err != nil {
+		return err
+	}
 
 	var wg sync.WaitGroup
 
@@ -127,6 +184,60 @@ func Start() error {
 		}
 	}()
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		if err := app.ReminderScheduler.InitSchedule(ctx); err != nil {
+			app.Logger.Error(ctx, "reminder scheduler stopped", err)
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		if err := app.NotificationDispatcher.InitDispatch(ctx); err != nil {
+			app.Logger.Error(ctx, "notification dispatcher stopped", err)
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		if err := app.WebhookDispatcher.InitDispatch(ctx); err != nil {
+			app.Logger.Error(ctx, "webhook dispatcher stopped", err)
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		if err := app.OutboxPoller.InitPoll(ctx); err != nil {
+			app.Logger.Error(ctx, "outbox poller stopped", err)
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		if err := app.TrashPurger.InitPurge(ctx); err != nil {
+			app.Logger.Error(ctx, "trash purger stopped", err)
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		if err := app.JobArchiver.InitArchive(ctx); err != nil {
+			app.Logger.Error(ctx, "job archiver stopped", err)
+		}
+	}()
+
 	go func() {
 		app.Logger.Info(ctx, "server started")
 		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -135,6 +246,14 @@ func Start() error {
 		}
 	}()
 
+	go func() {
+		app.Logger.Info(ctx, "grpc server started")
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			app.Logger.Error(ctx, "grpc server error", err)
+			stop()
+		}
+	}()
+
 	<-ctx.Done()
 	app.Logger.Info(ctx, "shutdown signal received")
 
@@ -145,6 +264,11 @@ func Start() error {
 		app.Logger.Error(ctx, "server shutdown error", err)
 	}
 
+	// gRPC gets its own grace period rather than what is left of the HTTP one.
+	grpcShutdownCtx, cancelGrpc := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelGrpc()
+	StopGrpcServer(grpcShutdownCtx, grpcServer, app.JobGrpcServer)
+
 	wg.Wait()
 
 	app.Logger.Info(ctx, "app stopped cleanly")
@@ -0,0 +1,44 @@
+package bootstrap
+
+import (
+	"fmt"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/metric"
+	"gorm.io/gorm"
+)
+
+const (
+	CacheBackendMemory = "memory"
+	CacheBackendRedis  = "redis"
+	CacheBackendNone   = "none"
+)
+
+// InitCache returns the job cache selected by CACHE_BACKEND, or nil when
+// caching is disabled. An unreachable Redis does not stop the boot: reads
+// fall back to the database until it comes back.
+func InitCache(cfg *Config) (infrastructure.JobCache, error) {
+	switch cfg.CacheBackend {
+	case CacheBackendMemory:
+		return infrastructure.NewMemoryJobCache(cfg.CacheSize, cfg.CacheTTL), nil
+	case CacheBackendRedis:
+		client := redis.NewClient(&redis.Options{Addr: cfg.RedisAddr})
+		return infrastructure.NewRedisJobCache(client, cfg.CacheTTL), nil
+	case CacheBackendNone:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown CACHE_BACKEND %q, expected %s, %s or %s", cfg.CacheBackend, CacheBackendMemory, CacheBackendRedis, CacheBackendNone)
+	}
+}
+
+// NewJobRepository puts the job cache, when there is one, in front of the
+// database repository so the services and the scrapper share it.
+func NewJobRepository(db *gorm.DB, cache infrastructure.JobCache, logger domain.Logger, metrics metric.MeterProvider) domain.JobRepository {
+	repository := infrastructure.NewJobRepository(db)
+	if cache == nil {
+		return repository
+	}
+	return infrastructure.NewCachedJobRepository(repository, cache, logger, metrics)
+}
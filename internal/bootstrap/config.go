@@ -1,44 +1,345 @@
 package bootstrap
 
 import (
-	"log"
+	"errors"
+	"fmt"
+	"job-tracker/internal/application"
+	"job-tracker/internal/infrastructure"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"github.com/subosito/gotenv"
 )
 
+// ConfigFileEnv names a config file when --config is not given. Without
+// either, job-tracker.yaml or job-tracker.toml is read from the working
+// directory if present.
+const ConfigFileEnv = "CONFIG_FILE"
+
 type Config struct {
 	Port       int
+	GRPCPort   int
+	DBDriver   string
+	DBPath     string
 	DBHost     string
 	DBPort     int
 	DBUser     string
 	DBPassword string
 	DBName     string
 	AppName    string
+
+	DBMaxOpenConns    int
+	DBMaxIdleConns    int
+	DBConnMaxLifetime time.Duration
+
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	SMTPFrom     string
+
+	TrashRetentionDays int
+
+	CacheBackend string
+	CacheSize    int
+	CacheTTL     time.Duration
+	RedisAddr    string
+
+	OTLPEndpoint     string
+	OTLPProtocol     string
+	LogsExporter     string
+	TracesExporter   string
+	TraceSampleRatio float64
+	MetricsExporter  string
+	ProfilingEnabled bool
+	PyroscopeAddress string
+
+	ScrapeInterval    time.Duration
+	ScrapeConcurrency int
+}
+
+// setting is one configuration key. The key is used in config files, the
+// flag is the key with dashes, and env keeps the variable names of .env.
+type setting struct {
+	key    string
+	env    string
+	value  any
+	usage  string
+	secret bool
+}
+
+var settings = []setting{
+	{key: "port", env: "PORT", value: 8080, usage: "HTTP port"},
+	{key: "grpc.port", env: "GRPC_PORT", value: 9090, usage: "gRPC port"},
+	{key: "app.name", env: "OTEL_SERVICE_NAME", value: "job-tracker", usage: "service name reported in telemetry"},
+	{key: "db.driver", env: "DB_DRIVER", value: DBDriverPostgres, usage: "database driver: postgres or sqlite"},
+	{key: "db.path", env: "DB_PATH", value: "job-tracker.db", usage: "SQLite file"},
+	{key: "db.host", env: "DB_HOST", value: "localhost", usage: "Postgres host"},
+	{key: "db.port", env: "DB_PORT", value: 5432, usage: "Postgres port"},
+	{key: "db.user", env: "DB_USER", value: "", usage: "Postgres user"},
+	{key: "db.password", env: "DB_PASSWORD", value: "", usage: "Postgres password", secret: true},
+	{key: "db.name", env: "DB_NAME", value: "", usage: "Postgres database"},
+	{key: "db.max_open_conns", env: "DB_MAX_OPEN_CONNS", value: 0, usage: "open connection limit, 0 for the driver default (25 Postgres, 4 SQLite)"},
+	{key: "db.max_idle_conns", env: "DB_MAX_IDLE_CONNS", value: 0, usage: "idle connection limit, 0 to match db.max_open_conns"},
+	{key: "db.conn_max_lifetime", env: "DB_CONN_MAX_LIFETIME", value: 5 * time.Minute, usage: "maximum connection age, 0 to keep connections forever"},
+	{key: "smtp.host", env: "SMTP_HOST", value: "", usage: "SMTP host, empty to disable email"},
+	{key: "smtp.port", env: "SMTP_PORT", value: 587, usage: "SMTP port"},
+	{key: "smtp.username", env: "SMTP_USERNAME", value: "", usage: "SMTP user"},
+	{key: "smtp.password", env: "SMTP_PASSWORD", value: "", usage: "SMTP password", secret: true},
+	{key: "smtp.from", env: "SMTP_FROM", value: "", usage: "sender address"},
+	{key: "trash.retention_days", env: "TRASH_RETENTION_DAYS", value: 30, usage: "days a deleted job stays in the trash"},
+	{key: "cache.backend", env: "CACHE_BACKEND", value: CacheBackendMemory, usage: "job cache: memory, redis or none"},
+	{key: "cache.size", env: "CACHE_SIZE", value: 1000, usage: "entries kept by the memory cache"},
+	{key: "cache.ttl", env: "CACHE_TTL", value: 30 * time.Second, usage: "job cache entry lifetime"},
+	{key: "redis.addr", env: "REDIS_ADDR", value: "localhost:6379", usage: "Redis address for the redis cache"},
+	{key: "otel.endpoint", env: "OTEL_EXPORTER_OTLP_ENDPOINT", value: "http://localhost:4318", usage: "OTLP collector URL"},
+	{key: "otel.protocol", env: "OTEL_EXPORTER_OTLP_PROTOCOL", value: OTLPProtocolHTTP, usage: "OTLP protocol: http/protobuf or grpc"},
+	{key: "otel.logs.exporter", env: "OTEL_LOGS_EXPORTER", value: ExporterOTLP, usage: "log exporter: otlp or none; logs are always written to stdout"},
+	{key: "otel.traces.exporter", env: "OTEL_TRACES_EXPORTER", value: ExporterOTLP, usage: "trace exporter: otlp, stdout or none"},
+	{key: "otel.traces.sample_ratio", env: "OTEL_TRACES_SAMPLER_ARG", value: 1.0, usage: "fraction of new traces sampled; spans follow their parent's decision"},
+	{key: "otel.metrics.exporter", env: "OTEL_METRICS_EXPORTER", value: ExporterOTLP, usage: "metric exporter: otlp, prometheus, stdout or none"},
+	{key: "pyroscope.enabled", env: "PYROSCOPE_ENABLED", value: true, usage: "send continuous profiles to Pyroscope"},
+	{key: "pyroscope.address", env: "PYROSCOPE_ADDRESS", value: "http://localhost:9999", usage: "Pyroscope server URL"},
+	{key: "scrape.interval", env: "SCRAPE_INTERVAL", value: 30 * time.Second, usage: "time between scrapes of job postings"},
+	{key: "scrape.concurrency", env: "SCRAPE_CONCURRENCY", value: 20, usage: "postings fetched at the same time"},
+}
+
+func flagName(key string) string {
+	return strings.NewReplacer(".", "-", "_", "-").Replace(key)
 }
 
-func LoadConfig() (*Config, error) {
-	viper.SetConfigFile(".env")
-	viper.AutomaticEnv()
+// BindConfigFlags adds --config and one flag per setting to flags.
+func BindConfigFlags(flags *pflag.FlagSet) {
+	flags.String("config", "", "YAML or TOML config file (env "+ConfigFileEnv+")")
+	for _, s := range settings {
+		name := flagName(s.key)
+		usage := s.usage + " (env " + s.env + ")"
+		switch value := s.value.(type) {
+		case int:
+			flags.Int(name, value, usage)
+		case float64:
+			flags.Float64(name, value, usage)
+		case bool:
+			flags.Bool(name, value, usage)
+		case time.Duration:
+			flags.Duration(name, value, usage)
+		default:
+			flags.String(name, value.(string), usage)
+		}
+	}
+}
 
-	err := viper.ReadInConfig()
+// readSettings resolves every setting from, lowest first: defaults, the
+// config file, .env, the environment and the flags that were set. flags may
+// be nil. Variables already in the environment win over .env.
+func readSettings(flags *pflag.FlagSet) (*viper.Viper, error) {
+	v := viper.New()
+	for _, s := range settings {
+		v.SetDefault(s.key, s.value)
+		_ = v.BindEnv(s.key, s.env)
+		if flags == nil {
+			continue
+		}
+		if flag := flags.Lookup(flagName(s.key)); flag != nil {
+			_ = v.BindPFlag(s.key, flag)
+		}
+	}
+
+	if err := gotenv.Load(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("reading .env: %w", err)
+	}
+
+	file := os.Getenv(ConfigFileEnv)
+	if flags != nil {
+		if flag := flags.Lookup("config"); flag != nil && flag.Changed {
+			file = flag.Value.String()
+		}
+	}
+	if file != "" {
+		v.SetConfigFile(file)
+	} else {
+		v.SetConfigName("job-tracker")
+		v.AddConfigPath(".")
+	}
+	if err := v.ReadInConfig(); err != nil {
+		var notFound viper.ConfigFileNotFoundError
+		if file != "" || !errors.As(err, &notFound) {
+			return nil, fmt.Errorf("reading config: %w", err)
+		}
+	}
+	return v, nil
+}
+
+// LoadConfig resolves the configuration and validates it, reporting every
+// problem at once.
+func LoadConfig(flags *pflag.FlagSet) (*Config, error) {
+	v, err := readSettings(flags)
 	if err != nil {
-		log.Println("No .env file found, using system env")
 		return nil, err
 	}
+	cfg := newConfig(v)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func newConfig(v *viper.Viper) *Config {
+	return &Config{
+		Port:       v.GetInt("port"),
+		GRPCPort:   v.GetInt("grpc.port"),
+		DBDriver:   v.GetString("db.driver"),
+		DBPath:     v.GetString("db.path"),
+		DBHost:     v.GetString("db.host"),
+		DBPort:     v.GetInt("db.port"),
+		DBUser:     v.GetString("db.user"),
+		DBPassword: v.GetString("db.password"),
+		DBName:     v.GetString("db.name"),
+		AppName:    v.GetString("app.name"),
+
+		DBMaxOpenConns:    v.GetInt("db.max_open_conns"),
+		DBMaxIdleConns:    v.GetInt("db.max_idle_conns"),
+		DBConnMaxLifetime: v.GetDuration("db.conn_max_lifetime"),
+
+		SMTPHost:     v.GetString("smtp.host"),
+		SMTPPort:     v.GetInt("smtp.port"),
+		SMTPUsername: v.GetString("smtp.username"),
+		SMTPPassword: v.GetString("smtp.password"),
+		SMTPFrom:     v.GetString("smtp.from"),
+
+		TrashRetentionDays: v.GetInt("trash.retention_days"),
+
+		CacheBackend: v.GetString("cache.backend"),
+		CacheSize:    v.GetInt("cache.size"),
+		CacheTTL:     v.GetDuration("cache.ttl"),
+		RedisAddr:    v.GetString("redis.addr"),
+
+		OTLPEndpoint:     v.GetString("otel.endpoint"),
+		OTLPProtocol:     v.GetString("otel.protocol"),
+		LogsExporter:     v.GetString("otel.logs.exporter"),
+		TracesExporter:   v.GetString("otel.traces.exporter"),
+		TraceSampleRatio: v.GetFloat64("otel.traces.sample_ratio"),
+		MetricsExporter:  v.GetString("otel.metrics.exporter"),
+		ProfilingEnabled: v.GetBool("pyroscope.enabled"),
+		PyroscopeAddress: v.GetString("pyroscope.address"),
+
+		ScrapeInterval:    v.GetDuration("scrape.interval"),
+		ScrapeConcurrency: v.GetInt("scrape.concurrency"),
+	}
+}
+
+// ConfigError lists every problem found in a configuration.
+type ConfigError struct {
+	Problems []string
+}
 
-	cfg := &Config{
-		Port:       viper.GetInt("PORT"),
-		DBHost:     viper.GetString("DB_HOST"),
-		DBPort:     viper.GetInt("DB_PORT"),
-		DBUser:     viper.GetString("DB_USER"),
-		DBPassword: viper.GetString("DB_PASSWORD"),
-		DBName:     viper.GetString("DB_NAME"),
-		AppName:    viper.GetString("OTEL_SERVICE_NAME"),
+func (e *ConfigError) Error() string {
+	return "invalid configuration:\n  " + strings.Join(e.Problems, "\n  ")
+}
+
+// Validate checks the whole configuration and returns a *ConfigError naming
+// each invalid setting, or nil.
+func (c *Config) Validate() error {
+	var problems []string
+	check := func(ok bool, key string, format string, args ...any) {
+		if ok {
+			return
+		}
+		env := ""
+		for _, s := range settings {
+			if s.key == key {
+				env = " (" + s.env + ")"
+			}
+		}
+		problems = append(problems, key+env+": "+fmt.Sprintf(format, args...))
+	}
+	validPort := func(port int) bool { return port > 0 && port <= 65535 }
+	validURL := func(raw string) bool {
+		parsed, err := url.Parse(raw)
+		return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+	}
+	oneOf := func(key string, value string, allowed ...string) {
+		check(slices.Contains(allowed, value), key, "must be one of %s, got %q", strings.Join(allowed, ", "), value)
 	}
 
-	if cfg.Port == 0 {
-		cfg.Port = 8080
+	check(validPort(c.Port), "port", "must be between 1 and 65535, got %d", c.Port)
+	check(validPort(c.GRPCPort), "grpc.port", "must be between 1 and 65535, got %d", c.GRPCPort)
+	check(c.GRPCPort != c.Port, "grpc.port", "must differ from port %d", c.Port)
+	check(c.AppName != "", "app.name", "is required")
+
+	switch c.DBDriver {
+	case DBDriverPostgres:
+		check(c.DBHost != "", "db.host", "is required with the postgres driver")
+		check(validPort(c.DBPort), "db.port", "must be between 1 and 65535, got %d", c.DBPort)
+		check(c.DBUser != "", "db.user", "is required with the postgres driver")
+		check(c.DBName != "", "db.name", "is required with the postgres driver")
+	case DBDriverSQLite:
+		check(c.DBPath != "", "db.path", "is required with the sqlite driver")
+	default:
+		check(false, "db.driver", "must be %s or %s, got %q", DBDriverPostgres, DBDriverSQLite, c.DBDriver)
 	}
+	check(c.DBMaxOpenConns >= 0, "db.max_open_conns", "must not be negative")
+	check(c.DBMaxIdleConns >= 0, "db.max_idle_conns", "must not be negative")
+	check(c.DBMaxOpenConns == 0 || c.DBMaxIdleConns <= c.DBMaxOpenConns, "db.max_idle_conns", "must not exceed db.max_open_conns (%d)", c.DBMaxOpenConns)
+	check(c.DBConnMaxLifetime >= 0, "db.conn_max_lifetime", "must not be negative")
 
-	return cfg, nil
+	check(validPort(c.SMTPPort), "smtp.port", "must be between 1 and 65535, got %d", c.SMTPPort)
+	check(c.SMTPHost == "" || c.SMTPFrom != "", "smtp.from", "is required when smtp.host is set")
+	check(c.TrashRetentionDays > 0, "trash.retention_days", "must be positive")
+
+	oneOf("cache.backend", c.CacheBackend, CacheBackendMemory, CacheBackendRedis, CacheBackendNone)
+	if c.CacheBackend != CacheBackendNone {
+		check(c.CacheTTL > 0, "cache.ttl", "must be positive")
+	}
+	if c.CacheBackend == CacheBackendMemory {
+		check(c.CacheSize > 0, "cache.size", "must be positive")
+	}
+	if c.CacheBackend == CacheBackendRedis {
+		check(c.RedisAddr != "", "redis.addr", "is required with the redis cache")
+	}
+
+	oneOf("otel.logs.exporter", c.LogsExporter, ExporterOTLP, ExporterNone)
+	oneOf("otel.traces.exporter", c.TracesExporter, ExporterOTLP, ExporterStdout, ExporterNone)
+	oneOf("otel.metrics.exporter", c.MetricsExporter, ExporterOTLP, ExporterPrometheus, ExporterStdout, ExporterNone)
+	if c.usesOTLP() {
+		oneOf("otel.protocol", c.OTLPProtocol, OTLPProtocolHTTP, OTLPProtocolGRPC)
+		check(validURL(c.OTLPEndpoint), "otel.endpoint", "must be an http(s) URL, got %q", c.OTLPEndpoint)
+	}
+	check(c.TraceSampleRatio >= 0 && c.TraceSampleRatio <= 1, "otel.traces.sample_ratio", "must be between 0 and 1, got %g", c.TraceSampleRatio)
+	if c.ProfilingEnabled {
+		check(validURL(c.PyroscopeAddress), "pyroscope.address", "must be an http(s) URL, got %q", c.PyroscopeAddress)
+	}
+	check(c.ScrapeInterval > 0, "scrape.interval", "must be positive")
+	check(c.ScrapeConcurrency > 0, "scrape.concurrency", "must be positive")
+
+	if len(problems) > 0 {
+		return &ConfigError{Problems: problems}
+	}
+	return nil
+}
+
+func NewSMTPConfig(cfg *Config) infrastructure.SMTPConfig {
+	return infrastructure.SMTPConfig{
+		Host:     cfg.SMTPHost,
+		Port:     cfg.SMTPPort,
+		Username: cfg.SMTPUsername,
+		Password: cfg.SMTPPassword,
+		From:     cfg.SMTPFrom,
+	}
+}
+
+func NewScrapeConfig(cfg *Config) infrastructure.ScrapeConfig {
+	return infrastructure.ScrapeConfig{
+		Interval:    cfg.ScrapeInterval,
+		Concurrency: cfg.ScrapeConcurrency,
+	}
+}
+
+func NewTrashRetention(cfg *Config) application.TrashRetention {
+	return application.TrashRetention(time.Duration(cfg.TrashRetentionDays) * 24 * time.Hour)
 }
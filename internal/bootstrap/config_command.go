@@ -0,0 +1,83 @@
+package bootstrap
+
+import (
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.yaml.in/yaml/v3"
+)
+
+const redacted = "[redacted]"
+
+// NewConfigCommand inspects the configuration the server would start with.
+func NewConfigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect the resolved configuration",
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "print",
+		Short: "Print the resolved configuration as YAML, with secrets redacted",
+		Long: "Prints every setting after applying defaults, the config file, .env, " +
+			"the environment and flags. The output can be used as a config file. " +
+			"Validation problems are reported after it.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v, err := readSettings(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			encoder := yaml.NewEncoder(cmd.OutOrStdout())
+			encoder.SetIndent(2)
+			if err := encoder.Encode(printableSettings(v)); err != nil {
+				return err
+			}
+			if err := encoder.Close(); err != nil {
+				return err
+			}
+			return newConfig(v).Validate()
+		},
+	})
+
+	return cmd
+}
+
+// printableSettings nests the resolved settings by key. Secrets that are set
+// are replaced, so the output can be shared.
+func printableSettings(v *viper.Viper) map[string]any {
+	root := map[string]any{}
+	for _, s := range settings {
+		var value any
+		switch s.value.(type) {
+		case int:
+			value = v.GetInt(s.key)
+		case float64:
+			value = v.GetFloat64(s.key)
+		case bool:
+			value = v.GetBool(s.key)
+		case time.Duration:
+			value = v.GetDuration(s.key).String()
+		default:
+			value = v.GetString(s.key)
+			if s.secret && value != "" {
+				value = redacted
+			}
+		}
+
+		parts := strings.Split(s.key, ".")
+		section := root
+		for _, part := range parts[:len(parts)-1] {
+			next, ok := section[part].(map[string]any)
+			if !ok {
+				next = map[string]any{}
+				section[part] = next
+			}
+			section = next
+		}
+		section[parts[len(parts)-1]] = value
+	}
+	return root
+}
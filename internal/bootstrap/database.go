@@ -1,15 +1,32 @@
 package bootstrap
 
 import (
+	"database/sql"
 	"fmt"
+	"job-tracker/internal/infrastructure"
 	"log"
-	"time"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
+const (
+	DBDriverPostgres = "postgres"
+	DBDriverSQLite   = "sqlite"
+)
+
 func InitDatabase(cfg *Config) (*gorm.DB, error) {
+	switch cfg.DBDriver {
+	case DBDriverPostgres:
+		return initPostgres(cfg)
+	case DBDriverSQLite:
+		return initSQLite(cfg)
+	default:
+		return nil, fmt.Errorf("unknown DB_DRIVER %q, expected %s or %s", cfg.DBDriver, DBDriverPostgres, DBDriverSQLite)
+	}
+}
+
+func initPostgres(cfg *Config) (*gorm.DB, error) {
 
 	dsn := fmt.Sprintf(
 		"host=%s user=%s password=%s dbname=%s port=%d sslmode=disable TimeZone=UTC",
@@ -30,12 +47,45 @@ func InitDatabase(cfg *Config) (*gorm.DB, error) {
 		return nil, err
 	}
 
-	// Connection pool
-	sqlDB.SetMaxOpenConns(25)
-	sqlDB.SetMaxIdleConns(25)
-	sqlDB.SetConnMaxLifetime(5 * time.Minute)
+	configurePool(sqlDB, cfg, 25)
 
 	log.Println("Postgres connected")
 
 	return db, nil
 }
+
+func initSQLite(cfg *Config) (*gorm.DB, error) {
+	db, err := infrastructure.OpenSQLite(cfg.DBPath, &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	// Writers are serialised by SQLite; a few connections are enough for
+	// concurrent readers, and keeping them open keeps the page cache warm.
+	configurePool(sqlDB, cfg, 4)
+
+	log.Printf("SQLite opened at %s", cfg.DBPath)
+
+	return db, nil
+}
+
+// configurePool applies the pool settings, using defaultOpen when no limit is
+// configured. Idle connections default to the open limit.
+func configurePool(sqlDB *sql.DB, cfg *Config, defaultOpen int) {
+	maxOpen := cfg.DBMaxOpenConns
+	if maxOpen == 0 {
+		maxOpen = defaultOpen
+	}
+	maxIdle := cfg.DBMaxIdleConns
+	if maxIdle == 0 {
+		maxIdle = maxOpen
+	}
+	sqlDB.SetMaxOpenConns(maxOpen)
+	sqlDB.SetMaxIdleConns(maxIdle)
+	sqlDB.SetConnMaxLifetime(cfg.DBConnMaxLifetime)
+}
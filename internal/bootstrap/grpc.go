@@ -0,0 +1,39 @@
+package bootstrap
+
+import (
+	"context"
+	"job-tracker/internal/infrastructure"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+func InitGrpcServer(app *App, tracer trace.TracerProvider, metrics metric.MeterProvider) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler(
+			otelgrpc.WithTracerProvider(tracer),
+			otelgrpc.WithMeterProvider(metrics),
+		)),
+	)
+	app.JobGrpcServer.Register(server)
+	return server
+}
+
+// StopGrpcServer ends the open watch streams, which would otherwise never
+// finish, then waits for in-flight calls and cancels whatever is still
+// running once ctx expires.
+func StopGrpcServer(ctx context.Context, server *grpc.Server, jobs *infrastructure.JobGrpcServer) {
+	jobs.CloseStreams()
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		server.Stop()
+	}
+}
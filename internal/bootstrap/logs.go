@@ -2,8 +2,10 @@ package bootstrap
 
 import (
 	"context"
+	"strings"
 
 	"go.opentelemetry.io/contrib/bridges/otelzap"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
 	"go.opentelemetry.io/otel/log/global"
 	"go.opentelemetry.io/otel/sdk/log"
@@ -11,21 +13,10 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
+// InitLogs builds the application logger. Records always go to stdout; with
+// the otlp exporter they are also sent to the collector. When the exporter
+// cannot be created the stdout logger is returned along with the error.
 func InitLogs(config *Config) (*zap.Logger, *log.LoggerProvider, error) {
-	exporter, err := otlploghttp.New(
-		context.Background(),
-		otlploghttp.WithEndpoint("localhost:4318"),
-		otlploghttp.WithInsecure(),
-		otlploghttp.WithURLPath("/v1/logs"),
-	)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	loggerProvider := log.NewLoggerProvider(
-		log.WithProcessor(log.NewBatchProcessor(exporter)),
-	)
-	global.SetLoggerProvider(loggerProvider)
 	cfg := zap.Config{
 		Level:            zap.NewAtomicLevelAt(zap.InfoLevel),
 		Development:      true,
@@ -45,9 +36,33 @@ func InitLogs(config *Config) (*zap.Logger, *log.LoggerProvider, error) {
 	}
 	baseLogger, _ := cfg.Build()
 
+	if config.LogsExporter == ExporterNone {
+		return baseLogger, nil, nil
+	}
+
+	exporter, err := newLogExporter(config)
+	if err != nil {
+		return baseLogger, nil, err
+	}
+
+	loggerProvider := log.NewLoggerProvider(
+		log.WithProcessor(log.NewBatchProcessor(exporter)),
+	)
+	global.SetLoggerProvider(loggerProvider)
+
 	otelCore := otelzap.NewCore(config.AppName, otelzap.WithLoggerProvider(loggerProvider))
 
 	logger := zap.New(zapcore.NewTee(baseLogger.Core(), otelCore))
 
 	return logger, loggerProvider, nil
 }
+
+func newLogExporter(config *Config) (log.Exporter, error) {
+	if config.OTLPProtocol == OTLPProtocolGRPC {
+		return otlploggrpc.New(context.Background(), otlploggrpc.WithEndpointURL(config.OTLPEndpoint))
+	}
+	return otlploghttp.New(
+		context.Background(),
+		otlploghttp.WithEndpointURL(strings.TrimSuffix(config.OTLPEndpoint, "/")+"/v1/logs"),
+	)
+}
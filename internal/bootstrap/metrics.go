@@ -2,28 +2,78 @@ package bootstrap
 
 import (
 	"context"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"net/http"
+	"strings"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.opentelemetry.io/contrib/instrumentation/runtime"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	otelprometheus "go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 )
 
-func InitMetrics() (*sdkmetric.MeterProvider, error) {
-	exporter, err := otlpmetrichttp.New(
-		context.Background(),
-		otlpmetrichttp.WithEndpoint("localhost:4318"),
-		otlpmetrichttp.WithInsecure(),
-		otlpmetrichttp.WithURLPath("/v1/metrics"),
-	)
-
-	meterProvider := sdkmetric.NewMeterProvider(
-		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter)),
-	)
+// InitMetrics returns the meter provider for the configured exporter. With
+// the prometheus exporter it also returns the handler to serve on /metrics.
+// When the exporter cannot be created the provider records nothing and the
+// error is returned for the caller to report.
+func InitMetrics(config *Config) (*sdkmetric.MeterProvider, http.Handler, error) {
+	var options []sdkmetric.Option
+	var handler http.Handler
+	var err error
+
+	switch config.MetricsExporter {
+	case ExporterNone:
+	case ExporterPrometheus:
+		registry := prometheus.NewRegistry()
+		var reader *otelprometheus.Exporter
+		reader, err = otelprometheus.New(otelprometheus.WithRegisterer(registry))
+		if err == nil {
+			options = append(options, sdkmetric.WithReader(reader))
+			handler = promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+		}
+	default:
+		var exporter sdkmetric.Exporter
+		exporter, err = newMetricExporter(config)
+		if err == nil {
+			options = append(options, sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter)))
+		}
+	}
+
+	meterProvider := sdkmetric.NewMeterProvider(options...)
 	otel.SetMeterProvider(meterProvider)
+	if err != nil {
+		return meterProvider, nil, err
+	}
 
 	if err := runtime.Start(runtime.WithMeterProvider(meterProvider)); err != nil {
-		return nil, err
+		return meterProvider, handler, err
 	}
-	return meterProvider, err
+	return meterProvider, handler, nil
+}
+
+func newMetricExporter(config *Config) (sdkmetric.Exporter, error) {
+	switch {
+	case config.MetricsExporter == ExporterStdout:
+		return stdoutmetric.New()
+	case config.OTLPProtocol == OTLPProtocolGRPC:
+		return otlpmetricgrpc.New(context.Background(), otlpmetricgrpc.WithEndpointURL(config.OTLPEndpoint))
+	default:
+		return otlpmetrichttp.New(
+			context.Background(),
+			otlpmetrichttp.WithEndpointURL(strings.TrimSuffix(config.OTLPEndpoint, "/")+"/v1/metrics"),
+		)
+	}
+}
+
+// NewEventSubscribers adds the business metrics to the application's outbox
+// subscribers.
+func NewEventSubscribers(reminders *application.ReminderService, notifications *application.NotificationService, webhooks *application.WebhookService, stream *application.EventStream, metrics *infrastructure.JobMetrics) []domain.EventSubscriber {
+	return append(application.NewEventSubscribers(reminders, notifications, webhooks, stream), metrics)
 }
@@ -0,0 +1,113 @@
+package bootstrap
+
+import (
+	"fmt"
+	"job-tracker/internal/infrastructure"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+// NewMigrateCommand manages the schema of the configured database.
+func NewMigrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply or roll back schema migrations",
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "up",
+		Short: "Apply every pending migration",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			migrator, err := openMigrator(cmd)
+			if err != nil {
+				return err
+			}
+			return migrator.Up(cmd.Context())
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "down [steps]",
+		Short: "Roll back the last migrations (default 1)",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			steps := 1
+			if len(args) == 1 {
+				parsed, err := strconv.Atoi(args[0])
+				if err != nil || parsed < 1 {
+					return fmt.Errorf("steps must be a positive number, got %q", args[0])
+				}
+				steps = parsed
+			}
+			migrator, err := openMigrator(cmd)
+			if err != nil {
+				return err
+			}
+			return migrator.Down(steps, cmd.Context())
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "status",
+		Short: "Show the schema version and which migrations are applied",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			migrator, err := openMigrator(cmd)
+			if err != nil {
+				return err
+			}
+			status, err := migrator.Status(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "VERSION\tNAME\tSTATE")
+			for _, migration := range status.Migrations {
+				state := "pending"
+				switch {
+				case status.Dirty && migration.Version == status.Version:
+					state = "dirty"
+				case migration.Applied:
+					state = "applied"
+				}
+				fmt.Fprintf(w, "%d\t%s\t%s\n", migration.Version, migration.Name, state)
+			}
+			return w.Flush()
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "force <version>",
+		Short: "Mark the schema clean at version after repairing a failed migration",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			version, err := strconv.Atoi(args[0])
+			if err != nil || version < 0 {
+				return fmt.Errorf("version must be a number, got %q", args[0])
+			}
+			migrator, err := openMigrator(cmd)
+			if err != nil {
+				return err
+			}
+			return migrator.Force(version, cmd.Context())
+		},
+	})
+
+	return cmd
+}
+
+func openMigrator(cmd *cobra.Command) (*infrastructure.Migrator, error) {
+	config, err := LoadConfig(cmd.Flags())
+	if err != nil {
+		return nil, err
+	}
+	db, err := InitDatabase(config)
+	if err != nil {
+		return nil, err
+	}
+	return infrastructure.NewMigrator(db)
+}
@@ -1,23 +1,20 @@
 package bootstrap
 
 import (
-	"log"
-
 	"github.com/grafana/pyroscope-go"
 )
 
+// InitProfile starts continuous profiling, or returns nil when it is off.
 func InitProfile(config *Config) (*pyroscope.Profiler, error) {
+	if !config.ProfilingEnabled {
+		return nil, nil
+	}
 
 	pyroscopeConfig := pyroscope.Config{
 		ApplicationName: config.AppName,
-		ServerAddress:   "http://localhost:9999",
+		ServerAddress:   config.PyroscopeAddress,
 		ProfileTypes:    pyroscope.DefaultProfileTypes,
 	}
 
-	profiler, err := pyroscope.Start(pyroscopeConfig)
-	if err != nil {
-		log.Println(err)
-		return nil, err
-	}
-	return profiler, nil
+	return pyroscope.Start(pyroscopeConfig)
 }
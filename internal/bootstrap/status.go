@@ -4,9 +4,19 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterStatus(r *gin.Engine) {
+// RegisterStatus adds /health, which lists the telemetry signals running
+// degraded, and /metrics when metrics are pulled by Prometheus.
+func RegisterStatus(r *gin.Engine, telemetry *Telemetry) {
 
 	r.GET("/health", func(c *gin.Context) {
-		c.JSON(200, gin.H{"status": "ok"})
+		status := gin.H{"status": "ok"}
+		if len(telemetry.Degraded) > 0 {
+			status["degraded"] = telemetry.Degraded
+		}
+		c.JSON(200, status)
 	})
+
+	if telemetry.MetricsHandler != nil {
+		r.GET("/metrics", gin.WrapH(telemetry.MetricsHandler))
+	}
 }
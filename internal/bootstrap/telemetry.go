@@ -0,0 +1,158 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	ExporterOTLP       = "otlp"
+	ExporterStdout     = "stdout"
+	ExporterPrometheus = "prometheus"
+	ExporterNone       = "none"
+
+	OTLPProtocolHTTP = "http/protobuf"
+	OTLPProtocolGRPC = "grpc"
+
+	SignalLogs     = "logs"
+	SignalTraces   = "traces"
+	SignalMetrics  = "metrics"
+	SignalProfiles = "profiles"
+)
+
+const endpointDialTimeout = 2 * time.Second
+
+// Telemetry holds the provider of every signal. A signal that is turned off
+// or failed to start gets a provider that records nothing, so the service
+// always starts; failed and unreachable signals are listed in Degraded.
+type Telemetry struct {
+	Logger         *zap.Logger
+	TracerProvider trace.TracerProvider
+	MeterProvider  metric.MeterProvider
+	// MetricsHandler serves the Prometheus scrape endpoint, or is nil when
+	// metrics are not pulled.
+	MetricsHandler http.Handler
+	Degraded       []string
+
+	shutdown []func(context.Context) error
+}
+
+// InitTelemetry starts every configured signal, logging a warning for each
+// one that cannot export.
+func InitTelemetry(config *Config) *Telemetry {
+	t := &Telemetry{}
+
+	logger, lp, err := InitLogs(config)
+	t.Logger = logger
+	if err != nil {
+		t.degrade(SignalLogs, err)
+	} else if lp != nil {
+		t.shutdown = append(t.shutdown, lp.Shutdown)
+	}
+
+	tracer, tp, err := InitTracer(config)
+	t.TracerProvider = tracer
+	if err != nil {
+		t.degrade(SignalTraces, err)
+	} else if tp != nil {
+		t.shutdown = append(t.shutdown, tp.Shutdown)
+	}
+
+	meters, handler, err := InitMetrics(config)
+	t.MeterProvider = meters
+	t.MetricsHandler = handler
+	t.shutdown = append(t.shutdown, meters.Shutdown)
+	if err != nil {
+		t.degrade(SignalMetrics, err)
+	}
+
+	profiler, err := InitProfile(config)
+	if err != nil {
+		t.degrade(SignalProfiles, err)
+	} else if profiler != nil {
+		t.shutdown = append(t.shutdown, func(context.Context) error { return profiler.Stop() })
+	}
+
+	// OTLP exporters connect lazily and keep retrying, so an unreachable
+	// collector is only reported; export resumes once it is back.
+	if config.usesOTLP() {
+		if err := dialEndpoint("collector", config.OTLPEndpoint); err != nil {
+			for _, signal := range config.otlpSignals() {
+				t.degrade(signal, err)
+			}
+		}
+	}
+	// The profiler uploads in the background and drops what it cannot send,
+	// so Pyroscope is probed the same way.
+	if profiler != nil {
+		if err := dialEndpoint("pyroscope", config.PyroscopeAddress); err != nil {
+			t.degrade(SignalProfiles, err)
+		}
+	}
+
+	return t
+}
+
+func (t *Telemetry) degrade(signal string, err error) {
+	t.Logger.Warn("telemetry degraded", zap.String("signal", signal), zap.Error(err))
+	if !slices.Contains(t.Degraded, signal) {
+		t.Degraded = append(t.Degraded, signal)
+	}
+}
+
+// Shutdown flushes and stops every signal that was started.
+func (t *Telemetry) Shutdown(ctx context.Context) error {
+	var errs []error
+	for _, shutdown := range slices.Backward(t.shutdown) {
+		errs = append(errs, shutdown(ctx))
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Config) otlpSignals() []string {
+	var signals []string
+	if c.LogsExporter == ExporterOTLP {
+		signals = append(signals, SignalLogs)
+	}
+	if c.TracesExporter == ExporterOTLP {
+		signals = append(signals, SignalTraces)
+	}
+	if c.MetricsExporter == ExporterOTLP {
+		signals = append(signals, SignalMetrics)
+	}
+	return signals
+}
+
+func (c *Config) usesOTLP() bool {
+	return len(c.otlpSignals()) > 0
+}
+
+func dialEndpoint(name string, endpoint string) error {
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+	address := parsed.Host
+	if parsed.Port() == "" {
+		port := "80"
+		if parsed.Scheme == "https" {
+			port = "443"
+		}
+		address = net.JoinHostPort(parsed.Hostname(), port)
+	}
+	conn, err := net.DialTimeout("tcp", address, endpointDialTimeout)
+	if err != nil {
+		return fmt.Errorf("%s at %s unreachable: %w", name, endpoint, err)
+	}
+	return conn.Close()
+}
@@ -2,36 +2,60 @@ package bootstrap
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	otelpyroscope "github.com/grafana/otel-profiling-go"
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
 	"go.opentelemetry.io/otel/propagation"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
-func InitTracer() (trace.TracerProvider, *sdktrace.TracerProvider, error) {
-	client := otlptracehttp.NewClient(
-		otlptracehttp.WithEndpoint("localhost:4318"),
-		otlptracehttp.WithInsecure(),
-		otlptracehttp.WithURLPath("/v1/traces"),
-		otlptracehttp.WithTimeout(5*time.Second),
-	)
-	exporter, err := otlptrace.New(context.Background(), client)
+// InitTracer returns the tracer provider for the configured exporter. New
+// traces are sampled at the configured ratio and spans with a parent follow
+// its decision. The SDK provider is nil when tracing is off.
+func InitTracer(config *Config) (trace.TracerProvider, *sdktrace.TracerProvider, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{}))
+
+	if config.TracesExporter == ExporterNone {
+		return noop.NewTracerProvider(), nil, nil
+	}
+
+	exporter, err := newSpanExporter(config)
 	if err != nil {
-		return nil, nil, err
+		return noop.NewTracerProvider(), nil, err
 	}
 
 	tp := sdktrace.NewTracerProvider(
-		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.TraceSampleRatio))),
 		sdktrace.WithBatcher(exporter),
 	)
 	enhancedTracerProvider := otelpyroscope.NewTracerProvider(tp)
 	otel.SetTracerProvider(enhancedTracerProvider)
-	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
-		propagation.TraceContext{}, propagation.Baggage{}))
-	return enhancedTracerProvider, tp, err
+	return enhancedTracerProvider, tp, nil
+}
+
+func newSpanExporter(config *Config) (sdktrace.SpanExporter, error) {
+	switch {
+	case config.TracesExporter == ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case config.OTLPProtocol == OTLPProtocolGRPC:
+		return otlptracegrpc.New(
+			context.Background(),
+			otlptracegrpc.WithEndpointURL(config.OTLPEndpoint),
+			otlptracegrpc.WithTimeout(5*time.Second),
+		)
+	default:
+		return otlptracehttp.New(
+			context.Background(),
+			otlptracehttp.WithEndpointURL(strings.TrimSuffix(config.OTLPEndpoint, "/")+"/v1/traces"),
+			otlptracehttp.WithTimeout(5*time.Second),
+		)
+	}
 }
@@ -5,21 +5,60 @@ package bootstrap
 
 import (
 	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
 	"job-tracker/internal/infrastructure"
 
 	"github.com/google/wire"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
 
 //go:generate wire
-func InitApp(logger *zap.Logger, db *gorm.DB) *App {
+func InitApp(config *Config, logger *zap.Logger, db *gorm.DB, cache infrastructure.JobCache, metrics metric.MeterProvider, tracer trace.TracerProvider) *App {
 	wire.Build(
 		infrastructure.NewLoggerZap,
-		infrastructure.NewJobRepository,
+		NewJobRepository,
 		infrastructure.NewJobScrapper,
+		infrastructure.NewReminderRepository,
+		NewSMTPConfig,
+		NewScrapeConfig,
+		infrastructure.NewNotificationSenders,
+		infrastructure.NewNotificationRepository,
+		application.NewNotificationService,
+		wire.Bind(new(domain.Notifier), new(*application.NotificationService)),
+		application.NewReminderService,
+		infrastructure.NewWebhookRepository,
+		infrastructure.NewWebhookClient,
+		application.NewWebhookService,
+		infrastructure.NewOutboxRepository,
+		application.NewEventStream,
+		NewEventSubscribers,
+		infrastructure.NewJobMetrics,
+		application.NewEventRelay,
+		infrastructure.NewOutboxPoller,
 		application.NewJobService,
+		NewTrashRetention,
+		application.NewTrashService,
+		application.NewArchiveService,
 		infrastructure.NewJobHandler,
+		infrastructure.NewReminderHandler,
+		infrastructure.NewNotificationHandler,
+		infrastructure.NewNotificationDispatcher,
+		infrastructure.NewWebhookHandler,
+		infrastructure.NewWebhookDispatcher,
+		infrastructure.NewEventStreamHandler,
+		infrastructure.NewTrashHandler,
+		infrastructure.NewTrashPurger,
+		infrastructure.NewArchiveHandler,
+		infrastructure.NewJobArchiver,
+		infrastructure.NewDocsHandler,
+		infrastructure.NewGraphQLHandler,
+		infrastructure.NewPostingReader,
+		infrastructure.NewWebHandler,
+		infrastructure.NewJobGrpcServer,
+		infrastructure.NewReminderScheduler,
 		NewApp,
 	)
 	return nil
@@ -0,0 +1,301 @@
+package cli
+
+import (
+	"cmp"
+	"fmt"
+	"job-tracker/internal/application"
+	"job-tracker/internal/client"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+var statuses = func() []string {
+	names := make([]string, len(domain.JobStatuses))
+	for i, status := range domain.JobStatuses {
+		names[i] = string(status)
+	}
+	return names
+}()
+
+// withBackend opens the configured backend for the duration of fn.
+func (o *options) withBackend(fn func(backend client.Backend) error) error {
+	backend, err := o.backend()
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	return fn(backend)
+}
+
+func newAddCommand(opts *options) *cobra.Command {
+	var request application.CreateJobRequest
+
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add a job application",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.withBackend(func(backend client.Backend) error {
+				job, err := backend.CreateJob(&request, cmd.Context())
+				if err != nil {
+					return err
+				}
+				return printJob(cmd.OutOrStdout(), opts.output(), job)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&request.Company, "company", "", "company name")
+	cmd.Flags().StringVar(&request.Position, "position", "", "position title")
+	cmd.Flags().StringVar(&request.Description, "description", "", "job description")
+	cmd.Flags().IntVar(&request.Salary, "salary", 0, "yearly salary")
+	cmd.Flags().BoolVar(&request.Remote, "remote", false, "remote position")
+	cmd.Flags().StringVar(&request.Url, "url", "", "posting URL")
+	for _, name := range []string{"company", "position", "description"} {
+		_ = cmd.MarkFlagRequired(name)
+	}
+	return cmd
+}
+
+func newImportCommand(opts *options) *cobra.Command {
+	var request application.CreateJobRequest
+
+	cmd := &cobra.Command{
+		Use:   "import <url>",
+		Short: "Add a job application from its posting URL",
+		Long: "Reads the company, position and description from the posting page. " +
+			"Flags override what was read; the API keeps the description up to date afterwards.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			found, err := infrastructure.NewPostingReader().Read(args[0], cmd.Context())
+			if err != nil {
+				return err
+			}
+			request.Company = cmp.Or(request.Company, found.Company)
+			request.Position = cmp.Or(request.Position, found.Position)
+			request.Description = cmp.Or(request.Description, found.Description)
+			request.Url = args[0]
+
+			return opts.withBackend(func(backend client.Backend) error {
+				job, err := backend.CreateJob(&request, cmd.Context())
+				if err != nil {
+					return err
+				}
+				return printJob(cmd.OutOrStdout(), opts.output(), job)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&request.Company, "company", "", "company name (default: read from the page)")
+	cmd.Flags().StringVar(&request.Position, "position", "", "position title (default: read from the page)")
+	cmd.Flags().StringVar(&request.Description, "description", "", "job description (default: read from the page)")
+	cmd.Flags().IntVar(&request.Salary, "salary", 0, "yearly salary")
+	cmd.Flags().BoolVar(&request.Remote, "remote", false, "remote position")
+	return cmd
+}
+
+func newListCommand(opts *options) *cobra.Command {
+	var status string
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:     "ls",
+		Aliases: []string{"list"},
+		Short:   "List job applications",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filter, err := parseStatusFilter(status)
+			if err != nil {
+				return err
+			}
+			return opts.withBackend(func(backend client.Backend) error {
+				jobs, err := backend.ListJobs(filter, all, cmd.Context())
+				if err != nil {
+					return err
+				}
+				return printJobs(cmd.OutOrStdout(), opts.output(), jobs)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&status, "status", "", "only jobs with this status")
+	cmd.Flags().BoolVarP(&all, "all", "a", false, "include archived jobs")
+	_ = cmd.RegisterFlagCompletionFunc("status", cobra.FixedCompletions(statuses, cobra.ShellCompDirectiveNoFileComp))
+	return cmd
+}
+
+func newShowCommand(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:               "show <id>",
+		Short:             "Show a job application",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeJobIds(opts, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseJobId(args[0])
+			if err != nil {
+				return err
+			}
+			return opts.withBackend(func(backend client.Backend) error {
+				job, err := backend.GetJob(id, cmd.Context())
+				if err != nil {
+					return err
+				}
+				return printJob(cmd.OutOrStdout(), opts.output(), job)
+			})
+		},
+	}
+}
+
+func newSetStatusCommand(opts *options) *cobra.Command {
+	var version int
+
+	cmd := &cobra.Command{
+		Use:   "set-status <id> <status>",
+		Short: "Change the status of a job application",
+		Args:  cobra.ExactArgs(2),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) == 1 {
+				return statuses, cobra.ShellCompDirectiveNoFileComp
+			}
+			return completeJobIds(opts, 1)(cmd, args, toComplete)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseJobId(args[0])
+			if err != nil {
+				return err
+			}
+			status, err := parseStatusFilter(args[1])
+			if err != nil {
+				return err
+			}
+			return opts.withBackend(func(backend client.Backend) error {
+				job, err := backend.UpdateJobStatus(id, &application.UpdateJobStatusRequest{Status: string(status), Version: version}, cmd.Context())
+				if err != nil {
+					return err
+				}
+				return printJob(cmd.OutOrStdout(), opts.output(), job)
+			})
+		},
+	}
+
+	cmd.Flags().IntVar(&version, "version", 0, "fail if the job is no longer at this version")
+	return cmd
+}
+
+func newRemoveCommand(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:               "rm <id>...",
+		Short:             "Move job applications to the trash",
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: completeJobIds(opts, -1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids := make([]uuid.UUID, len(args))
+			for i, arg := range args {
+				id, err := parseJobId(arg)
+				if err != nil {
+					return err
+				}
+				ids[i] = id
+			}
+			return opts.withBackend(func(backend client.Backend) error {
+				for _, id := range ids {
+					if err := backend.DeleteJob(id, cmd.Context()); err != nil {
+						return fmt.Errorf("%s: %w", id, err)
+					}
+					fmt.Fprintln(cmd.OutOrStdout(), "deleted", id)
+				}
+				return nil
+			})
+		},
+	}
+}
+
+func newExportCommand(opts *options) *cobra.Command {
+	var file string
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export every job application, archived ones included",
+		Long:  "Writes JSON unless -o yaml is given.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format := opts.output()
+			if format == outputTable {
+				format = outputJSON
+			}
+			return opts.withBackend(func(backend client.Backend) error {
+				jobs, err := backend.ListJobs("", true, cmd.Context())
+				if err != nil {
+					return err
+				}
+				if file == "" || file == "-" {
+					return printJobs(cmd.OutOrStdout(), format, jobs)
+				}
+
+				f, err := os.Create(file)
+				if err != nil {
+					return err
+				}
+				if err := printJobs(f, format, jobs); err != nil {
+					_ = f.Close()
+					return err
+				}
+				return f.Close()
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", "", "write to this file instead of stdout")
+	return cmd
+}
+
+// completeJobIds completes job ids for the first max arguments, or for all of
+// them when max is negative. Completion is best effort: errors yield nothing.
+func completeJobIds(opts *options, max int) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if max >= 0 && len(args) >= max {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		if opts.load() != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		var completions []string
+		_ = opts.withBackend(func(backend client.Backend) error {
+			jobs, err := backend.ListJobs("", false, cmd.Context())
+			if err != nil {
+				return err
+			}
+			for _, job := range jobs {
+				if id := job.Id.String(); strings.HasPrefix(id, toComplete) {
+					completions = append(completions, id+"\t"+job.Position+" at "+job.Company)
+				}
+			}
+			return nil
+		})
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+func parseJobId(value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid job id %q", value)
+	}
+	return id, nil
+}
+
+func parseStatusFilter(value string) (domain.JobStatus, error) {
+	if value == "" {
+		return "", nil
+	}
+	status := domain.JobStatusFromString(value)
+	if status == domain.JobStatusUnknown {
+		return "", fmt.Errorf("unknown status %q, expected one of %s", value, strings.Join(statuses, ", "))
+	}
+	return status, nil
+}
@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"job-tracker/internal/domain"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"go.yaml.in/yaml/v3"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+var outputFormats = []string{outputTable, outputJSON, outputYAML}
+
+func validOutput(format string) error {
+	for _, f := range outputFormats {
+		if f == format {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown output format %q, expected one of %s", format, strings.Join(outputFormats, ", "))
+}
+
+func printJobs(w io.Writer, format string, jobs []*domain.Job) error {
+	if jobs == nil {
+		jobs = []*domain.Job{}
+	}
+	switch format {
+	case outputJSON:
+		return writeJSON(w, jobs)
+	case outputYAML:
+		return writeYAML(w, jobs)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tCOMPANY\tPOSITION\tSTATUS\tSALARY\tREMOTE\tUPDATED")
+	for _, job := range jobs {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%t\t%s\n",
+			job.Id, job.Company, job.Position, job.Status, job.Salary, job.Remote, job.UpdatedAt.Local().Format(time.DateTime))
+	}
+	return tw.Flush()
+}
+
+func printJob(w io.Writer, format string, job *domain.Job) error {
+	switch format {
+	case outputJSON:
+		return writeJSON(w, job)
+	case outputYAML:
+		return writeYAML(w, job)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	rows := [][2]string{
+		{"ID", job.Id.String()},
+		{"Company", job.Company},
+		{"Position", job.Position},
+		{"Status", string(job.Status)},
+		{"Salary", strconv.Itoa(job.Salary)},
+		{"Remote", strconv.FormatBool(job.Remote)},
+		{"URL", job.Url},
+		{"Tags", strings.Join(job.Tags, ", ")},
+		{"Archived", strconv.FormatBool(job.Archived)},
+		{"Version", strconv.Itoa(job.Version)},
+		{"Created", job.CreatedAt.Local().Format(time.DateTime)},
+		{"Updated", job.UpdatedAt.Local().Format(time.DateTime)},
+		{"Description", job.Description},
+	}
+	for _, row := range rows {
+		fmt.Fprintf(tw, "%s:\t%s\n", row[0], row[1])
+	}
+	return tw.Flush()
+}
+
+func writeJSON(w io.Writer, value any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+// writeYAML goes through JSON so keys keep their API names and order.
+func writeYAML(w io.Writer, value any) error {
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(payload, &node); err != nil {
+		return err
+	}
+	blockStyle(&node)
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
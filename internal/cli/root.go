@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"job-tracker/internal/client"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// options holds the settings shared by every command. Values are resolved
+// from flags, then JOBCTL_* environment variables, then the config file.
+type options struct {
+	v          *viper.Viper
+	configFile string
+}
+
+func Execute() error {
+	return NewRootCommand().Execute()
+}
+
+func NewRootCommand() *cobra.Command {
+	opts := &options{v: viper.New()}
+
+	root := &cobra.Command{
+		Use:          "jobctl",
+		Short:        "Manage job applications from the terminal",
+		SilenceUsage: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.load(); err != nil {
+				return err
+			}
+			return validOutput(opts.output())
+		},
+	}
+
+	flags := root.PersistentFlags()
+	flags.StringVar(&opts.configFile, "config", "", "config file (default "+client.DefaultConfigFile()+")")
+	flags.StringP("output", "o", outputTable, "output format: "+strings.Join(outputFormats, ", "))
+	_ = opts.v.BindPFlag("output", flags.Lookup("output"))
+	client.BindFlags(flags, opts.v)
+	_ = root.RegisterFlagCompletionFunc("output", cobra.FixedCompletions(outputFormats, cobra.ShellCompDirectiveNoFileComp))
+
+	root.AddCommand(
+		newAddCommand(opts),
+		newImportCommand(opts),
+		newListCommand(opts),
+		newShowCommand(opts),
+		newSetStatusCommand(opts),
+		newRemoveCommand(opts),
+		newExportCommand(opts),
+	)
+	return root
+}
+
+func (o *options) load() error {
+	return client.LoadConfig(o.v, o.configFile)
+}
+
+func (o *options) output() string {
+	return o.v.GetString("output")
+}
+
+func (o *options) backend() (client.Backend, error) {
+	return client.Open(o.v)
+}
@@ -0,0 +1,91 @@
+// Package client gives the terminal tools one way to reach the tracker,
+// through the REST API or directly against a local SQLite file.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const DefaultServer = "http://localhost:8080/api/v1"
+
+type Backend interface {
+	CreateJob(request *application.CreateJobRequest, ctx context.Context) (*domain.Job, error)
+	GetJob(id uuid.UUID, ctx context.Context) (*domain.Job, error)
+	ListJobs(status domain.JobStatus, includeArchived bool, ctx context.Context) ([]*domain.Job, error)
+	UpdateJobStatus(id uuid.UUID, request *application.UpdateJobStatusRequest, ctx context.Context) (*domain.Job, error)
+	UpdateNotes(id uuid.UUID, notes string, version int, ctx context.Context) (*domain.Job, error)
+	DeleteJob(id uuid.UUID, ctx context.Context) error
+	// Watch streams the events that happen from now on until ctx is done.
+	Watch(ctx context.Context) (<-chan domain.Event, error)
+	Close() error
+}
+
+// BindFlags adds the connection flags shared by the terminal tools and binds
+// them to v, so values resolve from flags, then JOBCTL_* environment
+// variables, then the config file.
+func BindFlags(flags *pflag.FlagSet, v *viper.Viper) {
+	flags.String("server", DefaultServer, "API base URL")
+	flags.String("token", "", "API token, sent as a bearer token")
+	flags.Bool("local", false, "use a local SQLite file instead of the API")
+	flags.String("db", DefaultDataFile(), "SQLite file used with --local")
+	for _, name := range []string{"server", "token", "local", "db"} {
+		_ = v.BindPFlag(name, flags.Lookup(name))
+	}
+}
+
+// LoadConfig reads file into v, or the default config file when file is
+// empty. Only an explicitly named file has to exist.
+func LoadConfig(v *viper.Viper, file string) error {
+	v.SetEnvPrefix("jobctl")
+	v.AutomaticEnv()
+
+	if file != "" {
+		v.SetConfigFile(file)
+	} else {
+		v.SetConfigFile(DefaultConfigFile())
+	}
+	err := v.ReadInConfig()
+	if err != nil && (file != "" || !errors.Is(err, os.ErrNotExist)) {
+		return fmt.Errorf("reading config: %w", err)
+	}
+	return nil
+}
+
+// Open returns the backend selected by the settings bound in v.
+func Open(v *viper.Viper) (Backend, error) {
+	if !v.GetBool("local") {
+		return NewRESTBackend(v.GetString("server"), v.GetString("token")), nil
+	}
+
+	path := v.GetString("db")
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	return OpenLocalBackend(path)
+}
+
+func configDir() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "."
+	}
+	return filepath.Join(dir, "jobctl")
+}
+
+func DefaultConfigFile() string {
+	return filepath.Join(configDir(), "config.yaml")
+}
+
+func DefaultDataFile() string {
+	return filepath.Join(configDir(), "jobs.db")
+}
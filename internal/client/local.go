@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace/noop"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// pollInterval is how often Watch looks for new outbox events. Other
+// processes may write to the same file, so the outbox is the only source.
+const pollInterval = time.Second
+
+type localBackend struct {
+	db      *gorm.DB
+	service *application.JobService
+	outbox  domain.OutboxRepository
+}
+
+// OpenLocalBackend opens the SQLite file at path, creating it when missing.
+func OpenLocalBackend(path string) (Backend, error) {
+	db, err := infrastructure.OpenSQLite(path, &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		return nil, err
+	}
+	migrator, err := infrastructure.NewMigrator(db)
+	if err != nil {
+		return nil, err
+	}
+	if err := migrator.Up(context.Background()); err != nil {
+		return nil, err
+	}
+
+	log := infrastructure.NewLoggerZap(zap.NewNop())
+	return &localBackend{
+		db:      db,
+		service: application.NewJobService(infrastructure.NewJobRepository(db), log, noop.NewTracerProvider()),
+		outbox:  infrastructure.NewOutboxRepository(db),
+	}, nil
+}
+
+// CreateJob applies the same binding rules as the API before the service,
+// which trusts its callers to have validated the request.
+func (b *localBackend) CreateJob(request *application.CreateJobRequest, ctx context.Context) (*domain.Job, error) {
+	if err := binding.Validator.ValidateStruct(request); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidRequest, err)
+	}
+	return b.service.CreateJob(request, ctx)
+}
+
+func (b *localBackend) GetJob(id uuid.UUID, ctx context.Context) (*domain.Job, error) {
+	return b.service.GetJob(id, ctx)
+}
+
+func (b *localBackend) ListJobs(status domain.JobStatus, includeArchived bool, ctx context.Context) ([]*domain.Job, error) {
+	if status != "" {
+		return b.service.GetJobsByStatus(status, includeArchived, ctx)
+	}
+	return b.service.GetAllJobs(includeArchived, ctx)
+}
+
+func (b *localBackend) UpdateJobStatus(id uuid.UUID, request *application.UpdateJobStatusRequest, ctx context.Context) (*domain.Job, error) {
+	return b.service.UpdateJobStatus(id, request, ctx)
+}
+
+func (b *localBackend) UpdateNotes(id uuid.UUID, notes string, version int, ctx context.Context) (*domain.Job, error) {
+	patch, err := json.Marshal(map[string]string{"notes": notes})
+	if err != nil {
+		return nil, err
+	}
+	return b.service.PatchJob(id, &application.PatchJobRequest{
+		Format:  application.PatchFormatMerge,
+		Patch:   patch,
+		Version: version,
+	}, ctx)
+}
+
+func (b *localBackend) DeleteJob(id uuid.UUID, ctx context.Context) error {
+	return b.service.DeleteJob(id, ctx)
+}
+
+func (b *localBackend) Watch(ctx context.Context) (<-chan domain.Event, error) {
+	after, err := b.outbox.GetLatestSequence(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan domain.Event)
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+			batch, err := b.outbox.GetEventsAfter(after, 100, ctx)
+			if err != nil {
+				continue
+			}
+			for _, event := range batch {
+				select {
+				case events <- event:
+					after = event.Sequence
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return events, nil
+}
+
+func (b *localBackend) Close() error {
+	sqlDB, err := b.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
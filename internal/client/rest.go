@@ -0,0 +1,190 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type restBackend struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+// NewRESTBackend talks to the API mounted at server, e.g.
+// http://localhost:8080/api/v1. The token is sent as a bearer token.
+func NewRESTBackend(server string, token string) Backend {
+	return &restBackend{
+		baseURL: strings.TrimSuffix(server, "/"),
+		token:   token,
+		client:  &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// apiError is a problem+json response. It unwraps to the matching domain
+// error so commands report the same messages in both modes.
+type apiError struct {
+	problem domain.Problem
+}
+
+func (e *apiError) Error() string {
+	if e.problem.Detail != "" {
+		return e.problem.Detail
+	}
+	return e.problem.Title
+}
+
+func (e *apiError) Unwrap() error {
+	switch e.problem.Type {
+	case "/problems/not-found":
+		return domain.ErrJobNotFound
+	case "/problems/version-conflict":
+		return domain.ErrVersionConflict
+	case "/problems/invalid-request", "/problems/validation-error":
+		return domain.ErrInvalidRequest
+	default:
+		return nil
+	}
+}
+
+func (b *restBackend) CreateJob(request *application.CreateJobRequest, ctx context.Context) (*domain.Job, error) {
+	var job domain.Job
+	err := b.do(ctx, http.MethodPost, "/jobs", request, &job)
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (b *restBackend) GetJob(id uuid.UUID, ctx context.Context) (*domain.Job, error) {
+	var job domain.Job
+	err := b.do(ctx, http.MethodGet, "/jobs/"+id.String(), nil, &job)
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (b *restBackend) ListJobs(status domain.JobStatus, includeArchived bool, ctx context.Context) ([]*domain.Job, error) {
+	path := "/jobs"
+	if status != "" {
+		path = "/jobs/status/" + url.PathEscape(string(status))
+	}
+	if includeArchived {
+		path += "?includeArchived=true"
+	}
+
+	var jobs []*domain.Job
+	err := b.do(ctx, http.MethodGet, path, nil, &jobs)
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (b *restBackend) UpdateJobStatus(id uuid.UUID, request *application.UpdateJobStatusRequest, ctx context.Context) (*domain.Job, error) {
+	var job domain.Job
+	err := b.do(ctx, http.MethodPut, "/jobs/"+id.String()+"/status", request, &job)
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (b *restBackend) UpdateNotes(id uuid.UUID, notes string, version int, ctx context.Context) (*domain.Job, error) {
+	req, err := b.newRequest(ctx, http.MethodPatch, "/jobs/"+id.String(), map[string]string{"notes": notes})
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	if version != 0 {
+		req.Header.Set("If-Match", strconv.Quote(strconv.Itoa(version)))
+	}
+
+	var job domain.Job
+	if err := b.send(req, &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (b *restBackend) DeleteJob(id uuid.UUID, ctx context.Context) error {
+	return b.do(ctx, http.MethodDelete, "/jobs/"+id.String(), nil, nil)
+}
+
+func (b *restBackend) Close() error {
+	b.client.CloseIdleConnections()
+	return nil
+}
+
+func (b *restBackend) do(ctx context.Context, method string, path string, body any, out any) error {
+	req, err := b.newRequest(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+	return b.send(req, out)
+}
+
+func (b *restBackend) newRequest(ctx context.Context, method string, path string, body any) (*http.Request, error) {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, b.baseURL+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if b.token != "" {
+		req.Header.Set("Authorization", "Bearer "+b.token)
+	}
+	return req, nil
+}
+
+func (b *restBackend) send(req *http.Request, out any) error {
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return responseError(resp)
+	}
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return errors.Join(errors.New("invalid response body"), err)
+	}
+	return nil
+}
+
+func responseError(resp *http.Response) error {
+	var problem domain.Problem
+	if err := json.NewDecoder(resp.Body).Decode(&problem); err != nil || problem.Status == 0 {
+		return fmt.Errorf("unexpected HTTP status %d", resp.StatusCode)
+	}
+	return &apiError{problem: problem}
+}
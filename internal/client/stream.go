@@ -0,0 +1,112 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"job-tracker/internal/domain"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	reconnectMin = time.Second
+	reconnectMax = 30 * time.Second
+)
+
+// Watch follows /events/stream. It reconnects with Last-Event-ID after a
+// dropped connection so no event is lost or seen twice.
+func (b *restBackend) Watch(ctx context.Context) (<-chan domain.Event, error) {
+	resp, err := b.openStream(ctx, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan domain.Event)
+	go func() {
+		defer close(events)
+		lastId := int64(-1)
+		backoff := reconnectMin
+		for {
+			if resp != nil {
+				lastId = readStream(ctx, resp, lastId, events)
+				backoff = reconnectMin
+			}
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return
+			}
+			resp, err = b.openStream(ctx, lastId)
+			if err != nil {
+				resp = nil
+				backoff = min(backoff*2, reconnectMax)
+			}
+		}
+	}()
+	return events, nil
+}
+
+func (b *restBackend) openStream(ctx context.Context, lastId int64) (*http.Response, error) {
+	req, err := b.newRequest(ctx, http.MethodGet, "/events/stream", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if lastId >= 0 {
+		req.Header.Set("Last-Event-ID", strconv.FormatInt(lastId, 10))
+	}
+
+	// The shared client has a timeout, which would cut the stream.
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		if err := responseError(resp); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("unexpected HTTP status %d", resp.StatusCode)
+	}
+	return resp, nil
+}
+
+// readStream forwards events until the stream ends and returns the last
+// sequence it saw.
+func readStream(ctx context.Context, resp *http.Response, lastId int64, events chan<- domain.Event) int64 {
+	defer resp.Body.Close()
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1<<20)
+	var data strings.Builder
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line != "" {
+			if value, ok := strings.CutPrefix(line, "data:"); ok {
+				data.WriteString(strings.TrimPrefix(value, " "))
+			}
+			continue
+		}
+		if data.Len() == 0 {
+			continue
+		}
+
+		var event domain.Event
+		err := json.Unmarshal([]byte(data.String()), &event)
+		data.Reset()
+		if err != nil {
+			continue
+		}
+		select {
+		case events <- event:
+			lastId = event.Sequence
+		case <-ctx.Done():
+			return lastId
+		}
+	}
+	return lastId
+}
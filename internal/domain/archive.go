@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+// ArchiveRule archives jobs that have stayed in Status for at least After.
+// A PostingClosed rule instead matches jobs in any status whose posting has
+// been closed for at least After.
+type ArchiveRule struct {
+	Status        JobStatus
+	PostingClosed bool
+	After         time.Duration
+}
+
+var DefaultArchiveRules = []ArchiveRule{
+	{Status: JobStatusRejected, After: 30 * 24 * time.Hour},
+	{Status: JobStatusClosed, After: 0},
+	{PostingClosed: true, After: 0},
+}
+
+func (r ArchiveRule) Matches(job *Job, now time.Time) bool {
+	if job.Archived {
+		return false
+	}
+	if r.PostingClosed {
+		return job.PostingClosedAt != nil && !job.PostingClosedAt.Add(r.After).After(now)
+	}
+	return job.Status == r.Status && !job.StatusSince().Add(r.After).After(now)
+}
@@ -2,15 +2,31 @@ package domain
 
 import "errors"
 
-type ErrorResponse struct {
-	Error string `json:"error"`
+// Problem is an RFC 7807 problem details body, served as
+// application/problem+json.
+type Problem struct {
+	Type       string      `json:"type"`
+	Title      string      `json:"title"`
+	Status     int         `json:"status"`
+	Detail     string      `json:"detail,omitempty"`
+	Instance   string      `json:"instance,omitempty"`
+	TraceId    string      `json:"traceId,omitempty"`
+	Violations []Violation `json:"violations,omitempty"`
 }
 
-func NewErrorResponse(err error) ErrorResponse {
-	return ErrorResponse{Error: err.Error()}
+// Violation describes one request field that failed validation.
+type Violation struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
 }
 
 var ErrJobNotFound = errors.New("job not found")
 var ErrJobAlreadyExists = errors.New("job already exists")
+var ErrVersionConflict = errors.New("job was modified by another request")
+var ErrChannelNotFound = errors.New("notification channel not found")
+var ErrWebhookNotFound = errors.New("webhook not found")
+var ErrDeliveryNotFound = errors.New("delivery not found")
+var ErrBulkAborted = errors.New("bulk operation aborted")
 var ErrInvalidRequest = errors.New("invalid request")
 var ErrInternalServer = errors.New("internal server error")
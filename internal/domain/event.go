@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventType string
+
+const (
+	JobCreated       EventType = "job.created"
+	JobUpdated       EventType = "job.updated"
+	JobStatusChanged EventType = "job.status_changed"
+	JobDeleted       EventType = "job.deleted"
+	JobRestored      EventType = "job.restored"
+	JobArchived      EventType = "job.archived"
+	JobUnarchived    EventType = "job.unarchived"
+)
+
+type Event struct {
+	Id             uuid.UUID `json:"id"`
+	Sequence       int64     `json:"sequence,omitempty"`
+	Type           EventType `json:"type"`
+	JobId          uuid.UUID `json:"jobId"`
+	Job            *Job      `json:"job,omitempty"`
+	PreviousStatus JobStatus `json:"previousStatus,omitempty"`
+	OccurredAt     time.Time `json:"occurredAt"`
+}
+
+// EventSubscriber receives outbox events in order. Delivery is at-least-once,
+// so Handle must tolerate seeing the same event twice.
+type EventSubscriber interface {
+	Name() string
+	Handle(ctx context.Context, event Event) error
+}
+
+// LocalSubscriber serves listeners in this process only. Every replica must
+// relay to its own, so its position is kept in memory rather than in a
+// checkpoint shared with the other replicas.
+type LocalSubscriber interface {
+	EventSubscriber
+	Local()
+}
+
+// OutboxMessage is an event persisted in the same transaction as the change
+// that produced it.
+type OutboxMessage struct {
+	Sequence   int64     `gorm:"primaryKey;autoIncrement"`
+	EventId    uuid.UUID `gorm:"type:uuid;uniqueIndex"`
+	Type       EventType `gorm:"index"`
+	JobId      uuid.UUID `gorm:"type:uuid;index"`
+	Payload    string
+	OccurredAt time.Time
+}
+
+// OutboxCheckpoint is the last sequence a subscriber handled successfully.
+type OutboxCheckpoint struct {
+	Subscriber string `gorm:"primaryKey"`
+	Sequence   int64
+	UpdatedAt  time.Time
+}
+
+type OutboxRepository interface {
+	GetEventsAfter(sequence int64, limit int, ctx context.Context) ([]Event, error)
+	GetEventsForJob(jobId string, limit int, ctx context.Context) ([]Event, error)
+	GetLatestSequence(ctx context.Context) (int64, error)
+	GetCheckpoint(subscriber string, ctx context.Context) (int64, error)
+	SaveCheckpoint(subscriber string, sequence int64, ctx context.Context) error
+}
+
+func NewEvent(eventType EventType, jobId uuid.UUID, job *Job) Event {
+	return Event{
+		Id:         uuid.New(),
+		Type:       eventType,
+		JobId:      jobId,
+		Job:        job,
+		OccurredAt: time.Now(),
+	}
+}
@@ -1,10 +1,13 @@
 package domain
 
 import (
+	"context"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type JobStatus string
@@ -20,6 +23,38 @@ const (
 	JobStatusOffer     JobStatus = "OFFER"
 )
 
+type FieldSource string
+
+const (
+	FieldSourceUser    FieldSource = "USER"
+	FieldSourceScraper FieldSource = "SCRAPER"
+	FieldSourceRule    FieldSource = "RULE"
+)
+
+const (
+	JobFieldCompany     = "company"
+	JobFieldPosition    = "position"
+	JobFieldDescription = "description"
+	JobFieldStatus      = "status"
+	JobFieldSalary      = "salary"
+	JobFieldRemote      = "remote"
+	JobFieldUrl         = "url"
+	JobFieldArchived    = "archived"
+	JobFieldTags        = "tags"
+	JobFieldNotes       = "notes"
+)
+
+// JobStatuses lists the statuses a job can be in, in pipeline order.
+var JobStatuses = []JobStatus{
+	JobStatusOpen,
+	JobStatusPending,
+	JobStatusApplied,
+	JobStatusInterview,
+	JobStatusOffer,
+	JobStatusRejected,
+	JobStatusClosed,
+}
+
 type Job struct {
 	Id          uuid.UUID `json:"id" gorm:"type:uuid;primaryKey"`
 	Company     string    `json:"company" validate:"required,min=2"`
@@ -29,22 +64,49 @@ type Job struct {
 	Salary      int       `json:"salary"`
 	Remote      bool      `json:"remote"`
 	Url         string    `json:"url"`
+	Notes       string    `json:"notes"`
+	Version     int       `json:"version" gorm:"not null;default:1"`
+	// UserId is the user tracking the job, if it was created for one.
+	UserId string `json:"userId,omitempty" gorm:"index"`
+
+	Archived        bool       `json:"archived" gorm:"not null;default:false;index"`
+	ArchivedAt      *time.Time `json:"archivedAt,omitempty"`
+	StatusChangedAt *time.Time `json:"statusChangedAt,omitempty"`
+	// PostingClosedAt is when the scraper first found the posting closed. It
+	// is kept apart from Status, which the user may own.
+	PostingClosedAt *time.Time `json:"postingClosedAt,omitempty"`
+
+	Tags         []string               `json:"tags,omitempty" gorm:"serializer:json"`
+	FieldSources map[string]FieldSource `json:"fieldSources,omitempty" gorm:"serializer:json"`
+
+	CreatedAt time.Time      `json:"createdAt"`
+	UpdatedAt time.Time      `json:"updatedAt"`
+	DeletedAt gorm.DeletedAt `json:"deletedAt,omitempty" gorm:"index"`
 
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	events []Event
 }
 
 type JobRepository interface {
-	CreateJob(job *Job) error
-	GetJobById(id string) (*Job, error)
-	GetAll() ([]*Job, error)
-	UpdateJob(job *Job) error
-	DeleteJob(id string) error
-	GetJobsByStatus(status JobStatus) ([]*Job, error)
+	CreateJob(job *Job, ctx context.Context) error
+	GetJobById(id string, ctx context.Context) (*Job, error)
+	GetJobsByIds(ids []string, ctx context.Context) ([]*Job, error)
+	GetAll(includeArchived bool, ctx context.Context) ([]*Job, error)
+	UpdateJob(job *Job, ctx context.Context) error
+	DeleteJob(id string, ctx context.Context) error
+	GetJobsByStatus(status JobStatus, includeArchived bool, ctx context.Context) ([]*Job, error)
+	// CountByStatus counts jobs per status without loading them. Statuses
+	// with no jobs are absent.
+	CountByStatus(includeArchived bool, ctx context.Context) (map[JobStatus]int64, error)
+	GetDeleted(ctx context.Context) ([]*Job, error)
+	RestoreJob(id string, ctx context.Context) (*Job, error)
+	PurgeDeleted(before time.Time, ctx context.Context) (int64, error)
+	// Transaction runs fn with a repository bound to a single transaction.
+	// Nested calls roll back independently of the outer one.
+	Transaction(fn func(repository JobRepository) error, ctx context.Context) error
 }
 
 func NewJob(company string, position string, description string, salary int, remote bool, url string) *Job {
-	return &Job{
+	job := &Job{
 		Id:          uuid.New(),
 		Company:     company,
 		Position:    position,
@@ -53,9 +115,12 @@ func NewJob(company string, position string, description string, salary int, rem
 		Salary:      salary,
 		Remote:      remote,
 		Url:         url,
+		Version:     1,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
+	job.record(NewEvent(JobCreated, job.Id, job))
+	return job
 }
 
 func JobStatusFromString(status string) JobStatus {
@@ -71,17 +136,185 @@ func JobStatusFromString(status string) JobStatus {
 		return JobStatusApplied
 	case "INTERVIEW":
 		return JobStatusInterview
+	case "REJECTED":
+		return JobStatusRejected
+	case "OFFER":
+		return JobStatusOffer
 	default:
 		return JobStatusUnknown
 	}
 }
 
 func (j *Job) Update(company string, position string, description string, salary int, remote bool, url string) {
+	changed := j.setString(JobFieldCompany, &j.Company, company)
+	changed = j.setString(JobFieldPosition, &j.Position, position) || changed
+	changed = j.setString(JobFieldDescription, &j.Description, description) || changed
+	changed = j.setString(JobFieldUrl, &j.Url, url) || changed
+	if j.Salary != salary {
+		j.Salary = salary
+		j.markSource(JobFieldSalary, FieldSourceUser)
+		changed = true
+	}
+	if j.Remote != remote {
+		j.Remote = remote
+		j.markSource(JobFieldRemote, FieldSourceUser)
+		changed = true
+	}
+	if changed {
+		j.touch()
+	}
+}
+
+// SetNotes replaces the user's private notes on the job.
+func (j *Job) SetNotes(notes string) bool {
+	if !j.setString(JobFieldNotes, &j.Notes, notes) {
+		return false
+	}
+	j.touch()
+	return true
+}
+
+func (j *Job) ChangeStatus(status JobStatus) bool {
+	return j.changeStatus(status, FieldSourceUser)
+}
+
+// ApplyScraped updates the fields the scraper extracted from the posting and
+// reports whether anything changed. Empty values and fields last set by a
+// user are left untouched, but a closed posting is always recorded.
+func (j *Job) ApplyScraped(description string, status JobStatus) bool {
+	changed := false
+	if description != "" && description != j.Description && !j.IsUserOwned(JobFieldDescription) {
+		j.Description = description
+		j.markSource(JobFieldDescription, FieldSourceScraper)
+		j.touch()
+		changed = true
+	}
+	if status == JobStatusClosed && j.PostingClosedAt == nil {
+		now := time.Now()
+		j.PostingClosedAt = &now
+		j.touch()
+		changed = true
+	}
+	if status != "" && !j.IsUserOwned(JobFieldStatus) && j.changeStatus(status, FieldSourceScraper) {
+		changed = true
+	}
+	return changed
+}
+
+func (j *Job) IsUserOwned(field string) bool {
+	return j.FieldSources[field] == FieldSourceUser
+}
+
+func (j *Job) changeStatus(status JobStatus, source FieldSource) bool {
+	if j.Status == status {
+		return false
+	}
+	now := time.Now()
+	event := NewEvent(JobStatusChanged, j.Id, j)
+	event.PreviousStatus = j.Status
+	j.Status = status
+	j.StatusChangedAt = &now
+	j.markSource(JobFieldStatus, source)
+	// A new status makes the job eligible for archive rules again, even if a
+	// user unarchived it before.
+	delete(j.FieldSources, JobFieldArchived)
+	j.UpdatedAt = now
+	j.record(event)
+	return true
+}
+
+// Archive hides the job from default listings and from scraping. Archiving
+// by a rule never overrides a user who unarchived the job.
+func (j *Job) Archive(source FieldSource) bool {
+	if j.Archived || (source != FieldSourceUser && j.IsUserOwned(JobFieldArchived)) {
+		return false
+	}
+	now := time.Now()
+	j.Archived = true
+	j.ArchivedAt = &now
+	j.markSource(JobFieldArchived, source)
+	j.UpdatedAt = now
+	j.record(NewEvent(JobArchived, j.Id, j))
+	return true
+}
+
+func (j *Job) Unarchive() bool {
+	if !j.Archived {
+		return false
+	}
+	j.Archived = false
+	j.ArchivedAt = nil
+	j.markSource(JobFieldArchived, FieldSourceUser)
+	j.UpdatedAt = time.Now()
+	j.record(NewEvent(JobUnarchived, j.Id, j))
+	return true
+}
+
+// Tag adds and removes tags, which are stored trimmed, lower-cased and sorted.
+func (j *Job) Tag(add []string, remove []string) bool {
+	tags := slices.Clone(j.Tags)
+	for _, tag := range add {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag != "" && !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	tags = slices.DeleteFunc(tags, func(tag string) bool {
+		return slices.ContainsFunc(remove, func(r string) bool {
+			return strings.EqualFold(strings.TrimSpace(r), tag)
+		})
+	})
+	slices.Sort(tags)
+	if slices.Equal(tags, j.Tags) {
+		return false
+	}
+	j.Tags = tags
+	j.markSource(JobFieldTags, FieldSourceUser)
+	j.touch()
+	return true
+}
+
+// StatusSince returns when the job entered its current status, falling back
+// to the last update for jobs that predate status tracking.
+func (j *Job) StatusSince() time.Time {
+	if j.StatusChangedAt != nil {
+		return *j.StatusChangedAt
+	}
+	return j.UpdatedAt
+}
+
+func (j *Job) setString(field string, current *string, value string) bool {
+	if *current == value {
+		return false
+	}
+	*current = value
+	j.markSource(field, FieldSourceUser)
+	return true
+}
+
+func (j *Job) markSource(field string, source FieldSource) {
+	if j.FieldSources == nil {
+		j.FieldSources = make(map[string]FieldSource)
+	}
+	j.FieldSources[field] = source
+}
+
+// touch records a single update event however many fields changed together.
+func (j *Job) touch() {
 	j.UpdatedAt = time.Now()
-	j.Company = company
-	j.Position = position
-	j.Description = description
-	j.Salary = salary
-	j.Remote = remote
-	j.Url = url
+	if n := len(j.events); n > 0 && j.events[n-1].Type == JobUpdated {
+		return
+	}
+	j.record(NewEvent(JobUpdated, j.Id, j))
+}
+
+func (j *Job) record(event Event) {
+	j.events = append(j.events, event)
+}
+
+// PullEvents returns the events recorded since the last call and clears them.
+func (j *Job) PullEvents() []Event {
+	events := j.events
+	j.events = nil
+	return events
 }
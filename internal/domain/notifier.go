@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationEvent string
+
+const (
+	NotificationStatusChanged NotificationEvent = "STATUS_CHANGED"
+	NotificationPostingClosed NotificationEvent = "POSTING_CLOSED"
+	NotificationReminderDue   NotificationEvent = "REMINDER_DUE"
+	NotificationScrapeFailed  NotificationEvent = "SCRAPE_FAILED"
+)
+
+type ChannelKind string
+
+const (
+	ChannelKindEmail   ChannelKind = "EMAIL"
+	ChannelKindSlack   ChannelKind = "SLACK"
+	ChannelKindWebhook ChannelKind = "WEBHOOK"
+)
+
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "PENDING"
+	DeliveryStatusRetrying  DeliveryStatus = "RETRYING"
+	DeliveryStatusDelivered DeliveryStatus = "DELIVERED"
+	DeliveryStatusFailed    DeliveryStatus = "FAILED"
+)
+
+type Notification struct {
+	Event   NotificationEvent
+	Subject string
+	Message string
+	JobId   uuid.UUID
+	// UserId is the owner of the job. Only their channels are notified.
+	UserId string
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
+
+// NotificationSender delivers a notification through a single kind of channel.
+type NotificationSender interface {
+	Kind() ChannelKind
+	Send(ctx context.Context, channel *NotificationChannel, notification Notification) error
+}
+
+type NotificationChannel struct {
+	Id      uuid.UUID           `json:"id" gorm:"type:uuid;primaryKey"`
+	UserId  string              `json:"userId" gorm:"index"`
+	Kind    ChannelKind         `json:"kind"`
+	Target  string              `json:"target"`
+	Secret  string              `json:"-"`
+	Events  []NotificationEvent `json:"events" gorm:"serializer:json"`
+	Enabled bool                `json:"enabled"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// NotificationDelivery is a notification queued for one channel. It keeps
+// what the sender needs, so failed deliveries are retried in the background.
+type NotificationDelivery struct {
+	Id            uuid.UUID         `json:"id" gorm:"type:uuid;primaryKey"`
+	ChannelId     uuid.UUID         `json:"channelId" gorm:"type:uuid;index"`
+	JobId         uuid.UUID         `json:"jobId" gorm:"type:uuid"`
+	Event         NotificationEvent `json:"event"`
+	Subject       string            `json:"subject"`
+	Message       string            `json:"message"`
+	Status        DeliveryStatus    `json:"status" gorm:"index"`
+	Attempts      int               `json:"attempts"`
+	NextAttemptAt time.Time         `json:"nextAttemptAt" gorm:"index"`
+	LastError     string            `json:"lastError"`
+	CreatedAt     time.Time         `json:"createdAt"`
+	DeliveredAt   *time.Time        `json:"deliveredAt"`
+}
+
+type NotificationRepository interface {
+	CreateChannel(channel *NotificationChannel, ctx context.Context) error
+	UpdateChannel(channel *NotificationChannel, ctx context.Context) error
+	GetChannelById(id string, ctx context.Context) (*NotificationChannel, error)
+	GetChannelsByUser(userId string, ctx context.Context) ([]*NotificationChannel, error)
+	GetEnabledChannels(userId string, ctx context.Context) ([]*NotificationChannel, error)
+	DeleteChannel(id string, ctx context.Context) error
+	CreateDelivery(delivery *NotificationDelivery, ctx context.Context) error
+	UpdateDelivery(delivery *NotificationDelivery, ctx context.Context) error
+	GetDeliveriesByChannel(channelId string, ctx context.Context) ([]*NotificationDelivery, error)
+	GetDueDeliveries(now time.Time, limit int, ctx context.Context) ([]*NotificationDelivery, error)
+}
+
+func NewNotificationChannel(userId string, kind ChannelKind, target string, secret string, events []NotificationEvent) *NotificationChannel {
+	return &NotificationChannel{
+		Id:        uuid.New(),
+		UserId:    userId,
+		Kind:      kind,
+		Target:    target,
+		Secret:    secret,
+		Events:    events,
+		Enabled:   true,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+func (c *NotificationChannel) Subscribes(event NotificationEvent) bool {
+	return c.Enabled && slices.Contains(c.Events, event)
+}
+
+func (c *NotificationChannel) Update(events []NotificationEvent, enabled bool) {
+	c.Events = events
+	c.Enabled = enabled
+	c.UpdatedAt = time.Now()
+}
+
+func NewNotificationDelivery(channelId uuid.UUID, notification Notification) *NotificationDelivery {
+	return &NotificationDelivery{
+		Id:            uuid.New(),
+		ChannelId:     channelId,
+		JobId:         notification.JobId,
+		Event:         notification.Event,
+		Subject:       notification.Subject,
+		Message:       notification.Message,
+		Status:        DeliveryStatusPending,
+		NextAttemptAt: time.Now(),
+		CreatedAt:     time.Now(),
+	}
+}
+
+// Notification rebuilds the notification the delivery was queued for.
+func (d *NotificationDelivery) Notification() Notification {
+	return Notification{
+		Event:   d.Event,
+		Subject: d.Subject,
+		Message: d.Message,
+		JobId:   d.JobId,
+	}
+}
+
+func (d *NotificationDelivery) Delivered(at time.Time) {
+	d.Attempts++
+	d.Status = DeliveryStatusDelivered
+	d.LastError = ""
+	d.DeliveredAt = &at
+}
+
+// Failed schedules the next attempt with exponential backoff, or gives up
+// once maxAttempts is reached.
+func (d *NotificationDelivery) Failed(err error, at time.Time, maxAttempts int, backoff time.Duration) {
+	d.Attempts++
+	d.LastError = err.Error()
+	if d.Attempts >= maxAttempts {
+		d.Status = DeliveryStatusFailed
+		return
+	}
+	d.Status = DeliveryStatusRetrying
+	d.NextAttemptAt = at.Add(backoff * time.Duration(1<<(d.Attempts-1)))
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReminderRecurrence string
+
+const (
+	ReminderRecurrenceNone    ReminderRecurrence = "NONE"
+	ReminderRecurrenceDaily   ReminderRecurrence = "DAILY"
+	ReminderRecurrenceWeekly  ReminderRecurrence = "WEEKLY"
+	ReminderRecurrenceMonthly ReminderRecurrence = "MONTHLY"
+)
+
+type Reminder struct {
+	Id         uuid.UUID          `json:"id" gorm:"type:uuid;primaryKey"`
+	JobId      uuid.UUID          `json:"jobId" gorm:"type:uuid;index;uniqueIndex:idx_reminders_job_event,priority:1"`
+	DueAt      time.Time          `json:"dueAt" gorm:"index"`
+	Message    string             `json:"message"`
+	Recurrence ReminderRecurrence `json:"recurrence"`
+	FiredAt    *time.Time         `json:"firedAt"`
+	Done       bool               `json:"done"`
+	// EventId is the status change that scheduled the reminder, so a
+	// redelivered event does not schedule it twice. Nil for manual reminders.
+	EventId *uuid.UUID `json:"eventId,omitempty" gorm:"type:uuid;uniqueIndex:idx_reminders_job_event,priority:2"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type ReminderRepository interface {
+	CreateReminder(reminder *Reminder, ctx context.Context) error
+	UpdateReminder(reminder *Reminder, ctx context.Context) error
+	GetPending(ctx context.Context) ([]*Reminder, error)
+	GetDueBefore(before time.Time, ctx context.Context) ([]*Reminder, error)
+	GetByJobIds(jobIds []string, ctx context.Context) ([]*Reminder, error)
+	// CompleteRuleReminders marks the open reminders that status rules
+	// scheduled for the job as done, except the ones scheduled by keep.
+	CompleteRuleReminders(jobId string, keep uuid.UUID, ctx context.Context) error
+}
+
+// ReminderRule creates a follow-up reminder After a job enters Status. The
+// reminder is completed when the job leaves the status or is archived.
+type ReminderRule struct {
+	Status  JobStatus
+	After   time.Duration
+	Message string
+}
+
+var DefaultReminderRules = []ReminderRule{
+	{Status: JobStatusApplied, After: 7 * 24 * time.Hour, Message: "Follow up on your application"},
+	{Status: JobStatusInterview, After: 2 * 24 * time.Hour, Message: "Follow up after the interview"},
+	{Status: JobStatusOffer, After: 3 * 24 * time.Hour, Message: "Respond to the offer"},
+}
+
+func NewReminder(jobId uuid.UUID, dueAt time.Time, message string, recurrence ReminderRecurrence) *Reminder {
+	return &Reminder{
+		Id:         uuid.New(),
+		JobId:      jobId,
+		DueAt:      dueAt,
+		Message:    message,
+		Recurrence: recurrence,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+}
+
+func ReminderRecurrenceFromString(recurrence string) ReminderRecurrence {
+	switch strings.ToUpper(recurrence) {
+	case "DAILY":
+		return ReminderRecurrenceDaily
+	case "WEEKLY":
+		return ReminderRecurrenceWeekly
+	case "MONTHLY":
+		return ReminderRecurrenceMonthly
+	default:
+		return ReminderRecurrenceNone
+	}
+}
+
+// Fire marks the reminder as fired at the given time. Recurring reminders are
+// moved to their next occurrence instead of being completed.
+func (r *Reminder) Fire(at time.Time) {
+	r.FiredAt = &at
+	r.UpdatedAt = at
+	switch r.Recurrence {
+	case ReminderRecurrenceDaily:
+		r.DueAt = nextOccurrence(r.DueAt, at, 0, 0, 1)
+	case ReminderRecurrenceWeekly:
+		r.DueAt = nextOccurrence(r.DueAt, at, 0, 0, 7)
+	case ReminderRecurrenceMonthly:
+		r.DueAt = nextOccurrence(r.DueAt, at, 0, 1, 0)
+	default:
+		r.Done = true
+	}
+}
+
+func nextOccurrence(due time.Time, after time.Time, years int, months int, days int) time.Time {
+	next := due.AddDate(years, months, days)
+	for !next.After(after) {
+		next = next.AddDate(years, months, days)
+	}
+	return next
+}
@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "DELIVERED"
+	WebhookDeliveryRetrying  WebhookDeliveryStatus = "RETRYING"
+	WebhookDeliveryDead      WebhookDeliveryStatus = "DEAD"
+)
+
+type WebhookSubscription struct {
+	Id     uuid.UUID   `json:"id" gorm:"type:uuid;primaryKey"`
+	Url    string      `json:"url"`
+	Secret string      `json:"-"`
+	Events []EventType `json:"events" gorm:"serializer:json"`
+	Active bool        `json:"active"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type WebhookDelivery struct {
+	Id             uuid.UUID             `json:"id" gorm:"type:uuid;primaryKey"`
+	SubscriptionId uuid.UUID             `json:"subscriptionId" gorm:"type:uuid;index;uniqueIndex:idx_webhook_deliveries_subscription_event,priority:1"`
+	EventId        uuid.UUID             `json:"eventId" gorm:"type:uuid;uniqueIndex:idx_webhook_deliveries_subscription_event,priority:2"`
+	EventType      EventType             `json:"eventType"`
+	Payload        string                `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status" gorm:"index"`
+	Attempts       int                   `json:"attempts"`
+	NextAttemptAt  time.Time             `json:"nextAttemptAt" gorm:"index"`
+	ResponseStatus int                   `json:"responseStatus"`
+	LastError      string                `json:"lastError"`
+	DeliveredAt    *time.Time            `json:"deliveredAt"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type WebhookRepository interface {
+	CreateSubscription(subscription *WebhookSubscription, ctx context.Context) error
+	GetSubscriptionById(id string, ctx context.Context) (*WebhookSubscription, error)
+	GetSubscriptions(ctx context.Context) ([]*WebhookSubscription, error)
+	DeleteSubscription(id string, ctx context.Context) error
+	CreateDelivery(delivery *WebhookDelivery, ctx context.Context) error
+	UpdateDelivery(delivery *WebhookDelivery, ctx context.Context) error
+	GetDeliveryById(id string, ctx context.Context) (*WebhookDelivery, error)
+	GetDeliveriesBySubscription(subscriptionId string, ctx context.Context) ([]*WebhookDelivery, error)
+	GetDueDeliveries(now time.Time, limit int, ctx context.Context) ([]*WebhookDelivery, error)
+}
+
+// WebhookClient posts a signed payload to a subscriber and returns the HTTP status.
+type WebhookClient interface {
+	Post(ctx context.Context, subscription *WebhookSubscription, delivery *WebhookDelivery) (int, error)
+}
+
+func NewWebhookSubscription(url string, secret string, events []EventType) *WebhookSubscription {
+	return &WebhookSubscription{
+		Id:        uuid.New(),
+		Url:       url,
+		Secret:    secret,
+		Events:    events,
+		Active:    true,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// Matches reports whether the subscription wants the event. An empty filter
+// matches every event.
+func (s *WebhookSubscription) Matches(eventType EventType) bool {
+	return s.Active && (len(s.Events) == 0 || slices.Contains(s.Events, eventType))
+}
+
+func NewWebhookDelivery(subscriptionId uuid.UUID, event Event, payload string) *WebhookDelivery {
+	return &WebhookDelivery{
+		Id:             uuid.New(),
+		SubscriptionId: subscriptionId,
+		EventId:        event.Id,
+		EventType:      event.Type,
+		Payload:        payload,
+		Status:         WebhookDeliveryPending,
+		NextAttemptAt:  time.Now(),
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+}
+
+func (d *WebhookDelivery) Succeeded(responseStatus int, at time.Time) {
+	d.Attempts++
+	d.Status = WebhookDeliveryDelivered
+	d.ResponseStatus = responseStatus
+	d.LastError = ""
+	d.DeliveredAt = &at
+	d.UpdatedAt = at
+}
+
+// Failed schedules the next attempt with exponential backoff, or moves the
+// delivery to the dead-letter state once maxAttempts is reached.
+func (d *WebhookDelivery) Failed(responseStatus int, err error, at time.Time, maxAttempts int, backoff time.Duration) {
+	d.Attempts++
+	d.ResponseStatus = responseStatus
+	d.LastError = err.Error()
+	d.UpdatedAt = at
+	if d.Attempts >= maxAttempts {
+		d.Status = WebhookDeliveryDead
+		return
+	}
+	d.Status = WebhookDeliveryRetrying
+	d.NextAttemptAt = at.Add(backoff * time.Duration(1<<(d.Attempts-1)))
+}
+
+func (d *WebhookDelivery) Replay() {
+	d.Status = WebhookDeliveryPending
+	d.Attempts = 0
+	d.LastError = ""
+	d.ResponseStatus = 0
+	d.DeliveredAt = nil
+	d.NextAttemptAt = time.Now()
+	d.UpdatedAt = time.Now()
+}
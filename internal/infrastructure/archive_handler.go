@@ -0,0 +1,59 @@
+package infrastructure
+
+import (
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ArchiveHandler struct {
+	service *application.ArchiveService
+	logger  domain.Logger
+}
+
+func NewArchiveHandler(s *application.ArchiveService, logger domain.Logger) *ArchiveHandler {
+	return &ArchiveHandler{service: s, logger: logger}
+}
+
+func (h *ArchiveHandler) RegisterRoutes(r gin.IRouter) {
+	r.POST("/jobs/archive", h.ArchiveJobs)
+	r.POST("/jobs/unarchive", h.UnarchiveJobs)
+}
+
+func (h *ArchiveHandler) ArchiveJobs(c *gin.Context) {
+	h.logger.Info(c.Request.Context(), "archiving jobs")
+	var request application.ArchiveJobsRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(c.Request.Context(), "invalid request body", err)
+		bindError(c, err)
+		return
+	}
+	jobs, err := h.service.ArchiveJobs(&request, c.Request.Context())
+	isError := hasError(err, c)
+	if isError {
+		h.logger.Error(c.Request.Context(), "failed to archive jobs", err)
+		return
+	}
+	h.logger.Info(c.Request.Context(), "jobs archived successfully")
+	c.JSON(http.StatusOK, jobs)
+}
+
+func (h *ArchiveHandler) UnarchiveJobs(c *gin.Context) {
+	h.logger.Info(c.Request.Context(), "unarchiving jobs")
+	var request application.ArchiveJobsRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(c.Request.Context(), "invalid request body", err)
+		bindError(c, err)
+		return
+	}
+	jobs, err := h.service.UnarchiveJobs(&request, c.Request.Context())
+	isError := hasError(err, c)
+	if isError {
+		h.logger.Error(c.Request.Context(), "failed to unarchive jobs", err)
+		return
+	}
+	h.logger.Info(c.Request.Context(), "jobs unarchived successfully")
+	c.JSON(http.StatusOK, jobs)
+}
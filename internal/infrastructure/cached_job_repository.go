@@ -0,0 +1,226 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"job-tracker/internal/domain"
+	"slices"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const cacheMeterName = "job-tracker/cache"
+
+type cacheBypassKey struct{}
+
+// WithoutCache marks ctx so job reads made with it skip the cache and go to
+// the database. Fresh results are still stored for later readers.
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheBypassKey{}, true)
+}
+
+func cacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(cacheBypassKey{}).(bool)
+	return bypass
+}
+
+// CachedJobRepository is a read-through cache in front of a JobRepository.
+// Single jobs and the job lists are cached; every write through it drops the
+// written job and all lists. Reads inside a transaction always hit the
+// database, and the cache is invalidated once the transaction is over.
+type CachedJobRepository struct {
+	domain.JobRepository
+	cache  JobCache
+	logger domain.Logger
+	hits   metric.Int64Counter
+	misses metric.Int64Counter
+}
+
+func NewCachedJobRepository(repository domain.JobRepository, cache JobCache, logger domain.Logger, provider metric.MeterProvider) domain.JobRepository {
+	meter := provider.Meter(cacheMeterName)
+	// Instrument errors only come from invalid names; a no-op counter is
+	// returned alongside them.
+	hits, _ := meter.Int64Counter("job_tracker.cache.hits", metric.WithDescription("Job reads served from the cache"))
+	misses, _ := meter.Int64Counter("job_tracker.cache.misses", metric.WithDescription("Job reads that went to the database"))
+	return &CachedJobRepository{
+		JobRepository: repository,
+		cache:         cache,
+		logger:        logger,
+		hits:          hits,
+		misses:        misses,
+	}
+}
+
+func (r *CachedJobRepository) GetJobById(id string, ctx context.Context) (*domain.Job, error) {
+	return readThrough(r, "job", jobCacheKey(id), func() (*domain.Job, error) {
+		return r.JobRepository.GetJobById(id, ctx)
+	}, ctx)
+}
+
+func (r *CachedJobRepository) GetAll(includeArchived bool, ctx context.Context) ([]*domain.Job, error) {
+	return readThrough(r, "list", jobListCacheKey("all", includeArchived), func() ([]*domain.Job, error) {
+		return r.JobRepository.GetAll(includeArchived, ctx)
+	}, ctx)
+}
+
+func (r *CachedJobRepository) GetJobsByStatus(status domain.JobStatus, includeArchived bool, ctx context.Context) ([]*domain.Job, error) {
+	if !slices.Contains(domain.JobStatuses, status) {
+		return r.JobRepository.GetJobsByStatus(status, includeArchived, ctx)
+	}
+	return readThrough(r, "list", jobListCacheKey(string(status), includeArchived), func() ([]*domain.Job, error) {
+		return r.JobRepository.GetJobsByStatus(status, includeArchived, ctx)
+	}, ctx)
+}
+
+func (r *CachedJobRepository) CreateJob(job *domain.Job, ctx context.Context) error {
+	err := r.JobRepository.CreateJob(job, ctx)
+	r.invalidate(ctx, job.Id.String())
+	return err
+}
+
+// UpdateJob also invalidates on a version conflict: the caller read a stale
+// copy, possibly from the cache, and will reload before retrying.
+func (r *CachedJobRepository) UpdateJob(job *domain.Job, ctx context.Context) error {
+	err := r.JobRepository.UpdateJob(job, ctx)
+	r.invalidate(ctx, job.Id.String())
+	return err
+}
+
+func (r *CachedJobRepository) DeleteJob(id string, ctx context.Context) error {
+	err := r.JobRepository.DeleteJob(id, ctx)
+	r.invalidate(ctx, id)
+	return err
+}
+
+func (r *CachedJobRepository) RestoreJob(id string, ctx context.Context) (*domain.Job, error) {
+	job, err := r.JobRepository.RestoreJob(id, ctx)
+	r.invalidate(ctx, id)
+	return job, err
+}
+
+func (r *CachedJobRepository) PurgeDeleted(before time.Time, ctx context.Context) (int64, error) {
+	purged, err := r.JobRepository.PurgeDeleted(before, ctx)
+	if purged > 0 {
+		r.invalidate(ctx)
+	}
+	return purged, err
+}
+
+func (r *CachedJobRepository) Transaction(fn func(repository domain.JobRepository) error, ctx context.Context) error {
+	writes := &txWrites{}
+	err := r.JobRepository.Transaction(func(repository domain.JobRepository) error {
+		return fn(&writeRecorder{JobRepository: repository, writes: writes})
+	}, ctx)
+	if writes.dirty {
+		r.invalidate(ctx, writes.ids...)
+	}
+	return err
+}
+
+// invalidate drops the given jobs and every cached list. Failures are only
+// logged: entries left behind expire with the TTL.
+func (r *CachedJobRepository) invalidate(ctx context.Context, ids ...string) {
+	keys := make([]string, 0, len(ids)+2*(len(domain.JobStatuses)+1))
+	for _, id := range ids {
+		keys = append(keys, jobCacheKey(id))
+	}
+	for _, includeArchived := range []bool{false, true} {
+		keys = append(keys, jobListCacheKey("all", includeArchived))
+		for _, status := range domain.JobStatuses {
+			keys = append(keys, jobListCacheKey(string(status), includeArchived))
+		}
+	}
+	if err := r.cache.Delete(keys, ctx); err != nil {
+		r.logger.Error(ctx, "failed to invalidate job cache", err)
+	}
+}
+
+// readThrough serves key from the cache, or loads it and stores the result.
+// A failing cache is logged and treated as a miss.
+func readThrough[T any](r *CachedJobRepository, kind string, key string, load func() (T, error), ctx context.Context) (T, error) {
+	attributes := metric.WithAttributes(attribute.String("cache.kind", kind))
+	if !cacheBypassed(ctx) {
+		data, ok, err := r.cache.Get(key, ctx)
+		if err != nil {
+			r.logger.Error(ctx, "failed to read job cache", err, domain.Field{Key: "key", Value: key})
+		}
+		var value T
+		if ok && json.Unmarshal(data, &value) == nil {
+			r.hits.Add(ctx, 1, attributes)
+			return value, nil
+		}
+	}
+	r.misses.Add(ctx, 1, attributes)
+
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+	data, err := json.Marshal(value)
+	if err == nil {
+		err = r.cache.Set(key, data, ctx)
+	}
+	if err != nil {
+		r.logger.Error(ctx, "failed to write job cache", err, domain.Field{Key: "key", Value: key})
+	}
+	return value, nil
+}
+
+func jobCacheKey(id string) string {
+	return "job:" + id
+}
+
+func jobListCacheKey(filter string, includeArchived bool) string {
+	return "jobs:" + filter + ":" + strconv.FormatBool(includeArchived)
+}
+
+// txWrites collects what a transaction wrote, so the cache is invalidated
+// after it ends rather than while it can still roll back.
+type txWrites struct {
+	ids   []string
+	dirty bool
+}
+
+func (w *txWrites) record(ids ...string) {
+	w.ids = append(w.ids, ids...)
+	w.dirty = true
+}
+
+type writeRecorder struct {
+	domain.JobRepository
+	writes *txWrites
+}
+
+func (r *writeRecorder) CreateJob(job *domain.Job, ctx context.Context) error {
+	r.writes.record(job.Id.String())
+	return r.JobRepository.CreateJob(job, ctx)
+}
+
+func (r *writeRecorder) UpdateJob(job *domain.Job, ctx context.Context) error {
+	r.writes.record(job.Id.String())
+	return r.JobRepository.UpdateJob(job, ctx)
+}
+
+func (r *writeRecorder) DeleteJob(id string, ctx context.Context) error {
+	r.writes.record(id)
+	return r.JobRepository.DeleteJob(id, ctx)
+}
+
+func (r *writeRecorder) RestoreJob(id string, ctx context.Context) (*domain.Job, error) {
+	r.writes.record(id)
+	return r.JobRepository.RestoreJob(id, ctx)
+}
+
+func (r *writeRecorder) PurgeDeleted(before time.Time, ctx context.Context) (int64, error) {
+	r.writes.record()
+	return r.JobRepository.PurgeDeleted(before, ctx)
+}
+
+func (r *writeRecorder) Transaction(fn func(repository domain.JobRepository) error, ctx context.Context) error {
+	return r.JobRepository.Transaction(func(repository domain.JobRepository) error {
+		return fn(&writeRecorder{JobRepository: repository, writes: r.writes})
+	}, ctx)
+}
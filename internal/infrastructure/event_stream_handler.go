@@ -0,0 +1,173 @@
+package infrastructure
+
+import (
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const replayBatchSize = 200
+
+type EventStreamHandler struct {
+	stream    *application.EventStream
+	logger    domain.Logger
+	keepAlive time.Duration
+
+	closing   chan struct{}
+	closeOnce sync.Once
+}
+
+func NewEventStreamHandler(stream *application.EventStream, logger domain.Logger) *EventStreamHandler {
+	return &EventStreamHandler{stream: stream, logger: logger, keepAlive: 15 * time.Second, closing: make(chan struct{})}
+}
+
+// CloseStreams ends every open event stream, and any opened afterwards, so a
+// server shutdown does not wait on them. Clients reconnect with their last
+// event id.
+func (h *EventStreamHandler) CloseStreams() {
+	h.closeOnce.Do(func() { close(h.closing) })
+}
+
+func (h *EventStreamHandler) RegisterRoutes(r gin.IRouter) {
+	r.GET("/events/stream", h.Stream)
+}
+
+func (h *EventStreamHandler) Stream(c *gin.Context) {
+	ctx := c.Request.Context()
+	h.logger.Info(ctx, "event stream opened")
+
+	filter, lastId, ok := parseStreamParams(c)
+	if !ok {
+		return
+	}
+
+	events, unsubscribe := h.stream.Subscribe()
+	defer func() { unsubscribe() }()
+
+	if lastId < 0 {
+		latest, err := h.stream.LatestSequence(ctx)
+		isError := hasError(err, c)
+		if isError {
+			h.logger.Error(ctx, "failed to get latest event", err)
+			return
+		}
+		lastId = latest
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	lastId, ok = h.replay(c, lastId, filter)
+	if !ok {
+		return
+	}
+
+	keepAlive := time.NewTicker(h.keepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case event, open := <-events:
+			if !open {
+				// The stream dropped this listener for falling behind; listen
+				// again and catch up from the outbox.
+				events, unsubscribe = h.stream.Subscribe()
+				if lastId, ok = h.replay(c, lastId, filter); !ok {
+					return
+				}
+				continue
+			}
+			if event.Sequence <= lastId {
+				continue
+			}
+			lastId = h.write(c, event, filter)
+			c.Writer.Flush()
+		case <-keepAlive.C:
+			_, _ = c.Writer.WriteString(": keep-alive\n\n")
+			c.Writer.Flush()
+		case <-h.closing:
+			h.logger.Info(ctx, "event stream closed for shutdown")
+			return
+		case <-ctx.Done():
+			h.logger.Info(ctx, "event stream closed")
+			return
+		}
+	}
+}
+
+// replay writes the outbox events after lastId and returns the last sequence
+// it saw.
+func (h *EventStreamHandler) replay(c *gin.Context, lastId int64, filter application.EventFilter) (int64, bool) {
+	ctx := c.Request.Context()
+	for {
+		backlog, err := h.stream.Replay(lastId, replayBatchSize, ctx)
+		if err != nil {
+			h.logger.Error(ctx, "failed to replay events", err)
+			return lastId, false
+		}
+		for _, event := range backlog {
+			lastId = h.write(c, event, filter)
+		}
+		if len(backlog) < replayBatchSize {
+			break
+		}
+	}
+	c.Writer.Flush()
+	return lastId, true
+}
+
+func (h *EventStreamHandler) write(c *gin.Context, event domain.Event, filter application.EventFilter) int64 {
+	if filter.Matches(event) {
+		c.Render(-1, sse.Event{
+			Id:    strconv.FormatInt(event.Sequence, 10),
+			Event: string(event.Type),
+			Data:  event,
+		})
+	}
+	return event.Sequence
+}
+
+func parseStreamParams(c *gin.Context) (application.EventFilter, int64, bool) {
+	var filter application.EventFilter
+
+	if types := c.Query("types"); types != "" {
+		for _, t := range strings.Split(types, ",") {
+			filter.Types = append(filter.Types, domain.EventType(strings.TrimSpace(t)))
+		}
+	}
+
+	if jobId := c.Query("jobId"); jobId != "" {
+		id, err := uuid.Parse(jobId)
+		if err != nil {
+			invalidRequest(c, "jobId must be a valid UUID")
+			return filter, 0, false
+		}
+		filter.JobId = id
+	}
+	filter.UserId = c.Query("userId")
+
+	lastEventId := c.GetHeader("Last-Event-ID")
+	if lastEventId == "" {
+		lastEventId = c.Query("lastEventId")
+	}
+	if lastEventId == "" {
+		return filter, -1, true
+	}
+	lastId, err := strconv.ParseInt(lastEventId, 10, 64)
+	if err != nil || lastId < 0 {
+		invalidRequest(c, "last event id must be a non-negative sequence")
+		return filter, 0, false
+	}
+	return filter, lastId, true
+}
@@ -0,0 +1,101 @@
+package infrastructure
+
+import (
+	"errors"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const (
+	gormTracerName = "job-tracker/gorm"
+	gormSpanKey    = "otel:span"
+)
+
+// GormTracing is a GORM plugin that wraps every statement in a client span,
+// a child of the span in the statement's context. Repositories must pass the
+// request context with db.WithContext for the spans to join the request trace.
+type GormTracing struct {
+	tracer trace.Tracer
+}
+
+func NewGormTracing(provider trace.TracerProvider) *GormTracing {
+	return &GormTracing{tracer: provider.Tracer(gormTracerName)}
+}
+
+func (p *GormTracing) Name() string {
+	return "otel-tracing"
+}
+
+func (p *GormTracing) Initialize(db *gorm.DB) error {
+	callbacks := db.Callback()
+	return errors.Join(
+		callbacks.Create().Before("gorm:create").Register("otel:before_create", p.before),
+		callbacks.Create().After("gorm:create").Register("otel:after_create", p.after("INSERT")),
+		callbacks.Query().Before("gorm:query").Register("otel:before_query", p.before),
+		callbacks.Query().After("gorm:query").Register("otel:after_query", p.after("SELECT")),
+		callbacks.Update().Before("gorm:update").Register("otel:before_update", p.before),
+		callbacks.Update().After("gorm:update").Register("otel:after_update", p.after("UPDATE")),
+		callbacks.Delete().Before("gorm:delete").Register("otel:before_delete", p.before),
+		callbacks.Delete().After("gorm:delete").Register("otel:after_delete", p.after("DELETE")),
+		callbacks.Row().Before("gorm:row").Register("otel:before_row", p.before),
+		callbacks.Row().After("gorm:row").Register("otel:after_row", p.after("")),
+		callbacks.Raw().Before("gorm:raw").Register("otel:before_raw", p.before),
+		callbacks.Raw().After("gorm:raw").Register("otel:after_raw", p.after("")),
+	)
+}
+
+func (p *GormTracing) before(tx *gorm.DB) {
+	ctx, span := p.tracer.Start(tx.Statement.Context, "gorm", trace.WithSpanKind(trace.SpanKindClient))
+	tx.Statement.Context = ctx
+	tx.InstanceSet(gormSpanKey, span)
+}
+
+// after names the span once the statement is built: "<operation> <table>",
+// where raw statements take the operation from their first keyword. Bound
+// values are left out of the recorded statement.
+func (p *GormTracing) after(operation string) func(tx *gorm.DB) {
+	return func(tx *gorm.DB) {
+		value, ok := tx.InstanceGet(gormSpanKey)
+		if !ok {
+			return
+		}
+		span := value.(trace.Span)
+		defer span.End()
+
+		statement := tx.Statement.SQL.String()
+		if operation == "" {
+			operation, _, _ = strings.Cut(strings.TrimSpace(statement), " ")
+			operation = strings.ToUpper(operation)
+		}
+		span.SetName(strings.TrimSpace(operation + " " + tx.Statement.Table))
+		span.SetAttributes(
+			gormSystem(tx.Dialector.Name()),
+			semconv.DBOperationName(operation),
+			semconv.DBQueryText(statement),
+			attribute.Int64("db.rows_affected", tx.Statement.RowsAffected),
+		)
+		if tx.Statement.Table != "" {
+			span.SetAttributes(semconv.DBCollectionName(tx.Statement.Table))
+		}
+		if err := tx.Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+}
+
+func gormSystem(dialect string) attribute.KeyValue {
+	switch dialect {
+	case "postgres":
+		return semconv.DBSystemNamePostgreSQL
+	case "sqlite":
+		return semconv.DBSystemNameSQLite
+	default:
+		return semconv.DBSystemNameKey.String(dialect)
+	}
+}
@@ -0,0 +1,240 @@
+package infrastructure
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+	"github.com/graph-gophers/graphql-go"
+	gqlotel "github.com/graph-gophers/graphql-go/trace/otel"
+)
+
+//go:embed graphql/schema.graphqls
+var graphQLSchema string
+
+type graphQLRequest struct {
+	Query         string         `json:"query" form:"query" binding:"required"`
+	OperationName string         `json:"operationName" form:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+type GraphQLHandler struct {
+	schema   *graphql.Schema
+	resolver *graphQLResolver
+	logger   domain.Logger
+
+	closing   chan struct{}
+	closeOnce sync.Once
+}
+
+func NewGraphQLHandler(jobs *application.JobService, reminders *application.ReminderService, stream *application.EventStream, logger domain.Logger) *GraphQLHandler {
+	resolver := &graphQLResolver{jobs: jobs, reminders: reminders, stream: stream}
+	schema := graphql.MustParseSchema(graphQLSchema, resolver,
+		graphql.Tracer(gqlotel.DefaultTracer()),
+		graphql.MaxDepth(8),
+	)
+	return &GraphQLHandler{schema: schema, resolver: resolver, logger: logger, closing: make(chan struct{})}
+}
+
+// CloseStreams ends every open subscription, and any opened afterwards, so a
+// server shutdown does not wait on them.
+func (h *GraphQLHandler) CloseStreams() {
+	h.closeOnce.Do(func() { close(h.closing) })
+}
+
+// RegisterRoutes serves queries and mutations over POST. Subscriptions are
+// streamed as server-sent events, over GET for EventSource clients or over
+// POST with "Accept: text/event-stream". GET serves nothing but
+// subscriptions, so a link or an image cannot run a mutation.
+func (h *GraphQLHandler) RegisterRoutes(r gin.IRouter) {
+	r.POST("/graphql", h.Query)
+	r.GET("/graphql", h.Subscribe)
+}
+
+func (h *GraphQLHandler) Query(c *gin.Context) {
+	if strings.Contains(c.GetHeader("Accept"), "text/event-stream") {
+		h.Subscribe(c)
+		return
+	}
+
+	ctx := c.Request.Context()
+	request, ok := bindGraphQLRequest(c)
+	if !ok {
+		return
+	}
+
+	response := h.schema.Exec(h.withLoaders(ctx), request.Query, request.OperationName, request.Variables)
+	h.mapErrors(ctx, response)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *GraphQLHandler) Subscribe(c *gin.Context) {
+	ctx := c.Request.Context()
+	request, ok := bindGraphQLRequest(c)
+	if !ok {
+		return
+	}
+	// Schema.Subscribe runs queries and mutations as well.
+	if c.Request.Method == http.MethodGet && operationType(request.Query, request.OperationName) != "subscription" {
+		c.Header("Allow", "POST")
+		writeProblem(c, newProblem(http.StatusMethodNotAllowed, problemTypeInvalidRequest, "only subscriptions can be sent over GET, use POST"))
+		return
+	}
+
+	responses, err := h.schema.Subscribe(h.withLoaders(ctx), request.Query, request.OperationName, request.Variables)
+	if err != nil {
+		h.logger.Error(ctx, "failed to subscribe", err)
+		writeProblem(c, problemFor(err))
+		return
+	}
+	h.logger.Info(ctx, "graphql subscription opened")
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	for {
+		select {
+		case next, open := <-responses:
+			if !open {
+				c.Render(-1, sse.Event{Event: "complete", Data: ""})
+				c.Writer.Flush()
+				h.logger.Info(ctx, "graphql subscription completed")
+				return
+			}
+			response := next.(*graphql.Response)
+			h.mapErrors(ctx, response)
+			c.Render(-1, sse.Event{Event: "next", Data: response})
+			c.Writer.Flush()
+		case <-h.closing:
+			h.logger.Info(ctx, "graphql subscription closed for shutdown")
+			return
+		case <-ctx.Done():
+			h.logger.Info(ctx, "graphql subscription closed")
+			return
+		}
+	}
+}
+
+func bindGraphQLRequest(c *gin.Context) (graphQLRequest, bool) {
+	var request graphQLRequest
+	if c.Request.Method == http.MethodPost {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			bindError(c, err)
+			return request, false
+		}
+		return request, true
+	}
+
+	if err := c.ShouldBindQuery(&request); err != nil {
+		bindError(c, err)
+		return request, false
+	}
+	if variables := c.Query("variables"); variables != "" {
+		if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+			invalidRequest(c, "variables must be a JSON object")
+			return request, false
+		}
+	}
+	return request, true
+}
+
+func (h *GraphQLHandler) withLoaders(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loadersKey{}, h.resolver.newLoaders())
+}
+
+// mapErrors reports resolver errors with the same problem types as the REST
+// API, under extensions, and hides the details of internal ones.
+func (h *GraphQLHandler) mapErrors(ctx context.Context, response *graphql.Response) {
+	for _, queryError := range response.Errors {
+		if queryError.ResolverError == nil {
+			continue
+		}
+		problem := problemFor(queryError.ResolverError)
+		if problem.Status == http.StatusInternalServerError {
+			h.logger.Error(ctx, "graphql resolver failed", queryError.ResolverError)
+			queryError.Message = problem.Title
+		}
+		queryError.Extensions = map[string]any{"type": problem.Type, "status": problem.Status}
+	}
+}
+
+// operationType returns the type of the operation a document runs, "query",
+// "mutation" or "subscription", picked by name when it holds several. It only
+// scans the top level of the document and returns "" when the operation is
+// not found; the schema validates the rest.
+func operationType(document string, operationName string) string {
+	type operation struct{ kind, name string }
+	var operations []operation
+	definition, depth, named := "", 0, false
+	for i := 0; i < len(document); i++ {
+		switch c := document[i]; {
+		case c == '#':
+			for i < len(document) && document[i] != '\n' {
+				i++
+			}
+		case c == '"':
+			if strings.HasPrefix(document[i:], `"""`) {
+				end := strings.Index(document[i+3:], `"""`)
+				if end < 0 {
+					return ""
+				}
+				i += end + 5
+				continue
+			}
+			for i++; i < len(document) && document[i] != '"'; i++ {
+				if document[i] == '\\' {
+					i++
+				}
+			}
+		case c == '{' || c == '(' || c == '[':
+			if depth == 0 && c == '{' && definition == "" {
+				definition = "query"
+				operations = append(operations, operation{kind: definition})
+			}
+			depth++
+			named = true
+		case c == '}' || c == ')' || c == ']':
+			depth--
+			if depth == 0 && c == '}' {
+				definition = ""
+			}
+		case depth == 0 && (c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'):
+			start := i
+			for i+1 < len(document) && (document[i+1] == '_' || document[i+1] >= 'a' && document[i+1] <= 'z' ||
+				document[i+1] >= 'A' && document[i+1] <= 'Z' || document[i+1] >= '0' && document[i+1] <= '9') {
+				i++
+			}
+			name := document[start : i+1]
+			switch {
+			case definition == "":
+				definition, named = name, name == "fragment"
+				if name == "query" || name == "mutation" || name == "subscription" {
+					operations = append(operations, operation{kind: name})
+				}
+			case !named:
+				operations[len(operations)-1].name = name
+				named = true
+			}
+		case depth == 0 && c > ' ' && c != ',':
+			named = true
+		}
+	}
+
+	for _, op := range operations {
+		if op.name == operationName || operationName == "" && len(operations) == 1 {
+			return op.kind
+		}
+	}
+	return ""
+}
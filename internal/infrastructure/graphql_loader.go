@@ -0,0 +1,64 @@
+package infrastructure
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// batchLoader coalesces the loads issued while a GraphQL response is being
+// resolved into one fetch per wait window, dataloader style. graphql-go
+// resolves list items concurrently, so sibling loads land in the same batch.
+type batchLoader[K comparable, V any] struct {
+	fetch func(ctx context.Context, keys []K) (map[K]V, error)
+	wait  time.Duration
+
+	mu    sync.Mutex
+	batch *loaderBatch[K, V]
+}
+
+type loaderBatch[K comparable, V any] struct {
+	keys   []K
+	done   chan struct{}
+	values map[K]V
+	err    error
+}
+
+func newBatchLoader[K comparable, V any](wait time.Duration, fetch func(ctx context.Context, keys []K) (map[K]V, error)) *batchLoader[K, V] {
+	return &batchLoader[K, V]{fetch: fetch, wait: wait}
+}
+
+func (l *batchLoader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	batch := l.batch
+	if batch == nil {
+		batch = &loaderBatch[K, V]{done: make(chan struct{})}
+		l.batch = batch
+		// The batch serves every caller in the window, so the fetch must not
+		// fail because the one that opened it went away.
+		go l.dispatch(context.WithoutCancel(ctx), batch)
+	}
+	if !slices.Contains(batch.keys, key) {
+		batch.keys = append(batch.keys, key)
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-batch.done:
+		return batch.values[key], batch.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+func (l *batchLoader[K, V]) dispatch(ctx context.Context, batch *loaderBatch[K, V]) {
+	time.Sleep(l.wait)
+	l.mu.Lock()
+	l.batch = nil
+	l.mu.Unlock()
+
+	batch.values, batch.err = l.fetch(ctx, batch.keys)
+	close(batch.done)
+}
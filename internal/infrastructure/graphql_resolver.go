@@ -0,0 +1,369 @@
+package infrastructure
+
+import (
+	"context"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/graph-gophers/graphql-go"
+)
+
+const loaderWait = 2 * time.Millisecond
+
+type graphQLResolver struct {
+	jobs      *application.JobService
+	reminders *application.ReminderService
+	stream    *application.EventStream
+}
+
+// graphQLLoaders live for a single request so batches never mix callers.
+type graphQLLoaders struct {
+	jobs      *batchLoader[uuid.UUID, *domain.Job]
+	reminders *batchLoader[uuid.UUID, []*domain.Reminder]
+}
+
+type loadersKey struct{}
+
+func (r *graphQLResolver) newLoaders() *graphQLLoaders {
+	return &graphQLLoaders{
+		jobs: newBatchLoader(loaderWait, func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*domain.Job, error) {
+			jobs, err := r.jobs.GetJobsByIds(ids, ctx)
+			if err != nil {
+				return nil, err
+			}
+			byId := make(map[uuid.UUID]*domain.Job, len(jobs))
+			for _, job := range jobs {
+				byId[job.Id] = job
+			}
+			return byId, nil
+		}),
+		reminders: newBatchLoader(loaderWait, func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]*domain.Reminder, error) {
+			reminders, err := r.reminders.GetRemindersByJobIds(ids, ctx)
+			if err != nil {
+				return nil, err
+			}
+			byJob := make(map[uuid.UUID][]*domain.Reminder, len(ids))
+			for _, reminder := range reminders {
+				byJob[reminder.JobId] = append(byJob[reminder.JobId], reminder)
+			}
+			return byJob, nil
+		}),
+	}
+}
+
+func loadersFrom(ctx context.Context) *graphQLLoaders {
+	return ctx.Value(loadersKey{}).(*graphQLLoaders)
+}
+
+func parseGraphQLId(id graphql.ID) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(string(id))
+	if err != nil {
+		return uuid.Nil, domain.ErrInvalidRequest
+	}
+	return parsed, nil
+}
+
+func (r *graphQLResolver) Jobs(ctx context.Context, args struct {
+	Status          *string
+	IncludeArchived *bool
+}) ([]*jobResolver, error) {
+	includeArchived := args.IncludeArchived != nil && *args.IncludeArchived
+	var jobs []*domain.Job
+	var err error
+	if args.Status != nil {
+		jobs, err = r.jobs.GetJobsByStatus(domain.JobStatus(*args.Status), includeArchived, ctx)
+	} else {
+		jobs, err = r.jobs.GetAllJobs(includeArchived, ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toJobResolvers(jobs), nil
+}
+
+func (r *graphQLResolver) Job(ctx context.Context, args struct{ Id graphql.ID }) (*jobResolver, error) {
+	id, err := parseGraphQLId(args.Id)
+	if err != nil {
+		return nil, err
+	}
+	job, err := loadersFrom(ctx).jobs.Load(ctx, id)
+	if err != nil || job == nil {
+		return nil, err
+	}
+	return &jobResolver{job}, nil
+}
+
+func (r *graphQLResolver) Reminders(ctx context.Context, args struct{ Due *string }) ([]*reminderResolver, error) {
+	due := ""
+	if args.Due != nil {
+		due = *args.Due
+	}
+	reminders, err := r.reminders.GetReminders(due, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toReminderResolvers(reminders), nil
+}
+
+type createJobInput struct {
+	Company     string
+	Position    string
+	Description string
+	Salary      *int32
+	Remote      *bool
+	Url         *string
+	UserId      *string
+}
+
+func (r *graphQLResolver) CreateJob(ctx context.Context, args struct{ Input createJobInput }) (*jobResolver, error) {
+	input := args.Input
+	if !validJobFields(input.Company, input.Position, input.Description) {
+		return nil, domain.ErrInvalidRequest
+	}
+	job, err := r.jobs.CreateJob(&application.CreateJobRequest{
+		Company:     input.Company,
+		Position:    input.Position,
+		Description: input.Description,
+		Salary:      int(valueOr(input.Salary, 0)),
+		Remote:      valueOr(input.Remote, false),
+		Url:         valueOr(input.Url, ""),
+		UserId:      valueOr(input.UserId, ""),
+	}, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &jobResolver{job}, nil
+}
+
+type updateJobInput struct {
+	Id          graphql.ID
+	Company     string
+	Position    string
+	Description string
+	Salary      *int32
+	Remote      *bool
+	Url         *string
+	Version     *int32
+}
+
+func (r *graphQLResolver) UpdateJob(ctx context.Context, args struct{ Input updateJobInput }) (*jobResolver, error) {
+	input := args.Input
+	id, err := parseGraphQLId(input.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !validJobFields(input.Company, input.Position, input.Description) {
+		return nil, domain.ErrInvalidRequest
+	}
+	job, err := r.jobs.UpdateJob(&application.UpdateJobRequest{
+		Id:          id,
+		Company:     input.Company,
+		Position:    input.Position,
+		Description: input.Description,
+		Salary:      int(valueOr(input.Salary, 0)),
+		Remote:      valueOr(input.Remote, false),
+		Url:         valueOr(input.Url, ""),
+		Version:     int(valueOr(input.Version, 0)),
+	}, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &jobResolver{job}, nil
+}
+
+func (r *graphQLResolver) UpdateJobStatus(ctx context.Context, args struct {
+	Id      graphql.ID
+	Status  string
+	Version *int32
+}) (*jobResolver, error) {
+	id, err := parseGraphQLId(args.Id)
+	if err != nil {
+		return nil, err
+	}
+	job, err := r.jobs.UpdateJobStatus(id, &application.UpdateJobStatusRequest{
+		Status:  args.Status,
+		Version: int(valueOr(args.Version, 0)),
+	}, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &jobResolver{job}, nil
+}
+
+func (r *graphQLResolver) DeleteJob(ctx context.Context, args struct{ Id graphql.ID }) (bool, error) {
+	id, err := parseGraphQLId(args.Id)
+	if err != nil {
+		return false, err
+	}
+	if err := r.jobs.DeleteJob(id, ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// JobUpdated forwards live events until the subscriber goes away. Unlike the
+// SSE and gRPC streams it does not replay, since GraphQL clients re-query; a
+// subscriber that falls behind has its subscription ended instead.
+func (r *graphQLResolver) JobUpdated(ctx context.Context, args struct {
+	JobId  *graphql.ID
+	Types  *[]string
+	UserId *string
+}) (<-chan *jobEventResolver, error) {
+	filter := application.EventFilter{UserId: valueOr(args.UserId, "")}
+	if args.JobId != nil {
+		id, err := parseGraphQLId(*args.JobId)
+		if err != nil {
+			return nil, err
+		}
+		filter.JobId = id
+	}
+	if args.Types != nil {
+		for _, t := range *args.Types {
+			filter.Types = append(filter.Types, domain.EventType(t))
+		}
+	}
+
+	events, unsubscribe := r.stream.Subscribe()
+	updates := make(chan *jobEventResolver)
+	go func() {
+		defer unsubscribe()
+		defer close(updates)
+		for {
+			select {
+			case event, open := <-events:
+				if !open {
+					return
+				}
+				if !filter.Matches(event) {
+					continue
+				}
+				select {
+				case updates <- &jobEventResolver{event}:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return updates, nil
+}
+
+type jobResolver struct {
+	job *domain.Job
+}
+
+func toJobResolvers(jobs []*domain.Job) []*jobResolver {
+	resolvers := make([]*jobResolver, len(jobs))
+	for i, job := range jobs {
+		resolvers[i] = &jobResolver{job}
+	}
+	return resolvers
+}
+
+func (r *jobResolver) Id() graphql.ID          { return graphql.ID(r.job.Id.String()) }
+func (r *jobResolver) Company() string         { return r.job.Company }
+func (r *jobResolver) Position() string        { return r.job.Position }
+func (r *jobResolver) Description() string     { return r.job.Description }
+func (r *jobResolver) Status() string          { return string(r.job.Status) }
+func (r *jobResolver) Salary() int32           { return int32(r.job.Salary) }
+func (r *jobResolver) Remote() bool            { return r.job.Remote }
+func (r *jobResolver) Url() string             { return r.job.Url }
+func (r *jobResolver) Notes() string           { return r.job.Notes }
+func (r *jobResolver) Version() int32          { return int32(r.job.Version) }
+func (r *jobResolver) Archived() bool          { return r.job.Archived }
+func (r *jobResolver) CreatedAt() graphql.Time { return graphql.Time{Time: r.job.CreatedAt} }
+func (r *jobResolver) UpdatedAt() graphql.Time { return graphql.Time{Time: r.job.UpdatedAt} }
+
+func (r *jobResolver) Tags() []string {
+	if r.job.Tags == nil {
+		return []string{}
+	}
+	return r.job.Tags
+}
+
+func (r *jobResolver) UserId() *string {
+	if r.job.UserId == "" {
+		return nil
+	}
+	return &r.job.UserId
+}
+
+func (r *jobResolver) Reminders(ctx context.Context, args struct{ Pending *bool }) ([]*reminderResolver, error) {
+	reminders, err := loadersFrom(ctx).reminders.Load(ctx, r.job.Id)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*reminderResolver, 0, len(reminders))
+	for _, reminder := range reminders {
+		if args.Pending != nil && *args.Pending == reminder.Done {
+			continue
+		}
+		resolvers = append(resolvers, &reminderResolver{reminder})
+	}
+	return resolvers, nil
+}
+
+type reminderResolver struct {
+	reminder *domain.Reminder
+}
+
+func toReminderResolvers(reminders []*domain.Reminder) []*reminderResolver {
+	resolvers := make([]*reminderResolver, len(reminders))
+	for i, reminder := range reminders {
+		resolvers[i] = &reminderResolver{reminder}
+	}
+	return resolvers
+}
+
+func (r *reminderResolver) Id() graphql.ID      { return graphql.ID(r.reminder.Id.String()) }
+func (r *reminderResolver) DueAt() graphql.Time { return graphql.Time{Time: r.reminder.DueAt} }
+func (r *reminderResolver) Message() string     { return r.reminder.Message }
+func (r *reminderResolver) Recurrence() string  { return string(r.reminder.Recurrence) }
+func (r *reminderResolver) Done() bool          { return r.reminder.Done }
+
+func (r *reminderResolver) Job(ctx context.Context) (*jobResolver, error) {
+	job, err := loadersFrom(ctx).jobs.Load(ctx, r.reminder.JobId)
+	if err != nil || job == nil {
+		return nil, err
+	}
+	return &jobResolver{job}, nil
+}
+
+type jobEventResolver struct {
+	event domain.Event
+}
+
+func (r *jobEventResolver) Sequence() graphql.ID {
+	return graphql.ID(strconv.FormatInt(r.event.Sequence, 10))
+}
+func (r *jobEventResolver) Id() graphql.ID           { return graphql.ID(r.event.Id.String()) }
+func (r *jobEventResolver) Type() string             { return string(r.event.Type) }
+func (r *jobEventResolver) JobId() graphql.ID        { return graphql.ID(r.event.JobId.String()) }
+func (r *jobEventResolver) OccurredAt() graphql.Time { return graphql.Time{Time: r.event.OccurredAt} }
+
+func (r *jobEventResolver) Job() *jobResolver {
+	if r.event.Job == nil {
+		return nil
+	}
+	return &jobResolver{r.event.Job}
+}
+
+func (r *jobEventResolver) PreviousStatus() *string {
+	if r.event.PreviousStatus == "" {
+		return nil
+	}
+	status := string(r.event.PreviousStatus)
+	return &status
+}
+
+func valueOr[T any](value *T, fallback T) T {
+	if value == nil {
+		return fallback
+	}
+	return *value
+}
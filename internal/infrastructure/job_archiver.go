@@ -0,0 +1,44 @@
+package infrastructure
+
+import (
+	"context"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"time"
+)
+
+type JobArchiver struct {
+	service  *application.ArchiveService
+	log      domain.Logger
+	interval time.Duration
+}
+
+func NewJobArchiver(service *application.ArchiveService, log domain.Logger) *JobArchiver {
+	return &JobArchiver{
+		service:  service,
+		log:      log,
+		interval: time.Hour,
+	}
+}
+
+func (a *JobArchiver) InitArchive(ctx context.Context) error {
+
+	if err := a.service.ApplyRules(time.Now(), ctx); err != nil {
+		a.log.Error(ctx, "error archiving jobs", err)
+	}
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			if err := a.service.ApplyRules(now, ctx); err != nil {
+				a.log.Error(ctx, "error archiving jobs", err)
+			}
+		case <-ctx.Done():
+			a.log.Info(ctx, "job archiver stopped")
+			return nil
+		}
+	}
+}
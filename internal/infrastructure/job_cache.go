@@ -0,0 +1,85 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/redis/go-redis/v9"
+)
+
+const redisKeyPrefix = "job-tracker:"
+
+// JobCache stores serialized jobs and job lists under string keys. Entries
+// expire after the backend's TTL even when nothing invalidates them.
+type JobCache interface {
+	Get(key string, ctx context.Context) ([]byte, bool, error)
+	Set(key string, value []byte, ctx context.Context) error
+	Delete(keys []string, ctx context.Context) error
+}
+
+// MemoryJobCache keeps entries in a size-bounded LRU inside the process. Each
+// replica has its own copy, so writes on one replica reach the others only
+// after the TTL.
+type MemoryJobCache struct {
+	entries *expirable.LRU[string, []byte]
+}
+
+func NewMemoryJobCache(size int, ttl time.Duration) *MemoryJobCache {
+	return &MemoryJobCache{entries: expirable.NewLRU[string, []byte](size, nil, ttl)}
+}
+
+func (c *MemoryJobCache) Get(key string, ctx context.Context) ([]byte, bool, error) {
+	value, ok := c.entries.Get(key)
+	return value, ok, nil
+}
+
+func (c *MemoryJobCache) Set(key string, value []byte, ctx context.Context) error {
+	c.entries.Add(key, value)
+	return nil
+}
+
+func (c *MemoryJobCache) Delete(keys []string, ctx context.Context) error {
+	for _, key := range keys {
+		c.entries.Remove(key)
+	}
+	return nil
+}
+
+// RedisJobCache shares entries between replicas through any server speaking
+// the Redis protocol.
+type RedisJobCache struct {
+	client redis.UniversalClient
+	ttl    time.Duration
+}
+
+func NewRedisJobCache(client redis.UniversalClient, ttl time.Duration) *RedisJobCache {
+	return &RedisJobCache{client: client, ttl: ttl}
+}
+
+func (c *RedisJobCache) Get(key string, ctx context.Context) ([]byte, bool, error) {
+	value, err := c.client.Get(ctx, redisKeyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+func (c *RedisJobCache) Set(key string, value []byte, ctx context.Context) error {
+	return c.client.Set(ctx, redisKeyPrefix+key, value, c.ttl).Err()
+}
+
+func (c *RedisJobCache) Delete(keys []string, ctx context.Context) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = redisKeyPrefix + key
+	}
+	return c.client.Del(ctx, prefixed...).Err()
+}
@@ -0,0 +1,292 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	jobtrackerv1 "job-tracker/api/jobtracker/v1"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"sync"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// JobGrpcServer serves the JobTracker gRPC service on top of the same
+// application services as the REST handlers.
+type JobGrpcServer struct {
+	jobtrackerv1.UnimplementedJobTrackerServer
+	service *application.JobService
+	stream  *application.EventStream
+	logger  domain.Logger
+
+	closing   chan struct{}
+	closeOnce sync.Once
+}
+
+func NewJobGrpcServer(s *application.JobService, stream *application.EventStream, logger domain.Logger) *JobGrpcServer {
+	return &JobGrpcServer{service: s, stream: stream, logger: logger, closing: make(chan struct{})}
+}
+
+// CloseStreams ends every open WatchJobs stream, and any opened afterwards,
+// so a graceful stop does not wait on them.
+func (s *JobGrpcServer) CloseStreams() {
+	s.closeOnce.Do(func() { close(s.closing) })
+}
+
+func (s *JobGrpcServer) Register(server grpc.ServiceRegistrar) {
+	jobtrackerv1.RegisterJobTrackerServer(server, s)
+}
+
+func (s *JobGrpcServer) CreateJob(ctx context.Context, request *jobtrackerv1.CreateJobRequest) (*jobtrackerv1.Job, error) {
+	if !validJobFields(request.GetCompany(), request.GetPosition(), request.GetDescription()) {
+		return nil, status.Error(codes.InvalidArgument, "company, position and description need at least 2 characters")
+	}
+	job, err := s.service.CreateJob(&application.CreateJobRequest{
+		Company:     request.GetCompany(),
+		Position:    request.GetPosition(),
+		Description: request.GetDescription(),
+		Salary:      int(request.GetSalary()),
+		Remote:      request.GetRemote(),
+		Url:         request.GetUrl(),
+		UserId:      request.GetUserId(),
+	}, ctx)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return toProtoJob(job), nil
+}
+
+func (s *JobGrpcServer) GetJob(ctx context.Context, request *jobtrackerv1.GetJobRequest) (*jobtrackerv1.Job, error) {
+	id, err := parseGrpcId(request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	job, err := s.service.GetJob(id, ctx)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return toProtoJob(job), nil
+}
+
+func (s *JobGrpcServer) ListJobs(ctx context.Context, request *jobtrackerv1.ListJobsRequest) (*jobtrackerv1.ListJobsResponse, error) {
+	jobs, err := s.service.GetAllJobs(request.GetIncludeArchived(), ctx)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return toProtoJobs(jobs), nil
+}
+
+func (s *JobGrpcServer) ListJobsByStatus(ctx context.Context, request *jobtrackerv1.ListJobsByStatusRequest) (*jobtrackerv1.ListJobsResponse, error) {
+	jobs, err := s.service.GetJobsByStatus(domain.JobStatusFromString(request.GetStatus()), request.GetIncludeArchived(), ctx)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return toProtoJobs(jobs), nil
+}
+
+func (s *JobGrpcServer) UpdateJob(ctx context.Context, request *jobtrackerv1.UpdateJobRequest) (*jobtrackerv1.Job, error) {
+	id, err := parseGrpcId(request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if !validJobFields(request.GetCompany(), request.GetPosition(), request.GetDescription()) {
+		return nil, status.Error(codes.InvalidArgument, "company, position and description need at least 2 characters")
+	}
+	job, err := s.service.UpdateJob(&application.UpdateJobRequest{
+		Id:          id,
+		Company:     request.GetCompany(),
+		Position:    request.GetPosition(),
+		Description: request.GetDescription(),
+		Salary:      int(request.GetSalary()),
+		Remote:      request.GetRemote(),
+		Url:         request.GetUrl(),
+		Version:     int(request.GetVersion()),
+	}, ctx)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return toProtoJob(job), nil
+}
+
+func (s *JobGrpcServer) UpdateJobStatus(ctx context.Context, request *jobtrackerv1.UpdateJobStatusRequest) (*jobtrackerv1.Job, error) {
+	id, err := parseGrpcId(request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	job, err := s.service.UpdateJobStatus(id, &application.UpdateJobStatusRequest{
+		Status:  request.GetStatus(),
+		Version: int(request.GetVersion()),
+	}, ctx)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return toProtoJob(job), nil
+}
+
+func (s *JobGrpcServer) DeleteJob(ctx context.Context, request *jobtrackerv1.DeleteJobRequest) (*emptypb.Empty, error) {
+	id, err := parseGrpcId(request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.service.DeleteJob(id, ctx); err != nil {
+		return nil, grpcError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// WatchJobs replays the requested backlog from the outbox and then follows
+// live events, skipping any already sent during the replay.
+func (s *JobGrpcServer) WatchJobs(request *jobtrackerv1.WatchJobsRequest, stream grpc.ServerStreamingServer[jobtrackerv1.JobEvent]) error {
+	ctx := stream.Context()
+	filter := application.EventFilter{}
+	for _, t := range request.GetTypes() {
+		filter.Types = append(filter.Types, domain.EventType(t))
+	}
+	if request.GetJobId() != "" {
+		id, err := parseGrpcId(request.GetJobId())
+		if err != nil {
+			return err
+		}
+		filter.JobId = id
+	}
+	filter.UserId = request.GetUserId()
+
+	events, unsubscribe := s.stream.Subscribe()
+	defer func() { unsubscribe() }()
+
+	lastId := request.GetAfterSequence()
+	if request.AfterSequence == nil {
+		latest, err := s.stream.LatestSequence(ctx)
+		if err != nil {
+			return grpcError(err)
+		}
+		lastId = latest
+	}
+
+	lastId, err := s.replay(stream, lastId, filter)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case event, open := <-events:
+			if !open {
+				// The stream dropped this listener for falling behind; listen
+				// again and catch up from the outbox.
+				events, unsubscribe = s.stream.Subscribe()
+				if lastId, err = s.replay(stream, lastId, filter); err != nil {
+					return err
+				}
+				continue
+			}
+			if event.Sequence <= lastId {
+				continue
+			}
+			if err := s.send(stream, event, filter); err != nil {
+				return err
+			}
+			lastId = event.Sequence
+		case <-s.closing:
+			return status.Error(codes.Unavailable, "server is shutting down")
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// replay sends the outbox events after lastId and returns the last sequence
+// it saw.
+func (s *JobGrpcServer) replay(stream grpc.ServerStreamingServer[jobtrackerv1.JobEvent], lastId int64, filter application.EventFilter) (int64, error) {
+	for {
+		backlog, err := s.stream.Replay(lastId, replayBatchSize, stream.Context())
+		if err != nil {
+			return lastId, grpcError(err)
+		}
+		for _, event := range backlog {
+			if err := s.send(stream, event, filter); err != nil {
+				return lastId, err
+			}
+			lastId = event.Sequence
+		}
+		if len(backlog) < replayBatchSize {
+			return lastId, nil
+		}
+	}
+}
+
+func (s *JobGrpcServer) send(stream grpc.ServerStreamingServer[jobtrackerv1.JobEvent], event domain.Event, filter application.EventFilter) error {
+	if !filter.Matches(event) {
+		return nil
+	}
+	message := &jobtrackerv1.JobEvent{
+		Sequence:       event.Sequence,
+		Id:             event.Id.String(),
+		Type:           string(event.Type),
+		JobId:          event.JobId.String(),
+		PreviousStatus: string(event.PreviousStatus),
+		OccurredAt:     timestamppb.New(event.OccurredAt),
+	}
+	if event.Job != nil {
+		message.Job = toProtoJob(event.Job)
+	}
+	return stream.Send(message)
+}
+
+func toProtoJob(job *domain.Job) *jobtrackerv1.Job {
+	return &jobtrackerv1.Job{
+		Id:          job.Id.String(),
+		Company:     job.Company,
+		Position:    job.Position,
+		Description: job.Description,
+		Status:      string(job.Status),
+		Salary:      int64(job.Salary),
+		Remote:      job.Remote,
+		Url:         job.Url,
+		Version:     int64(job.Version),
+		Archived:    job.Archived,
+		Tags:        job.Tags,
+		CreatedAt:   timestamppb.New(job.CreatedAt),
+		UpdatedAt:   timestamppb.New(job.UpdatedAt),
+	}
+}
+
+func toProtoJobs(jobs []*domain.Job) *jobtrackerv1.ListJobsResponse {
+	response := &jobtrackerv1.ListJobsResponse{Jobs: make([]*jobtrackerv1.Job, 0, len(jobs))}
+	for _, job := range jobs {
+		response.Jobs = append(response.Jobs, toProtoJob(job))
+	}
+	return response
+}
+
+func validJobFields(company string, position string, description string) bool {
+	return len(company) >= 2 && len(position) >= 2 && len(description) >= 2
+}
+
+func parseGrpcId(id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "id must be a valid UUID")
+	}
+	return parsed, nil
+}
+
+// grpcError maps domain errors to status codes the way hasError maps them to
+// HTTP statuses.
+func grpcError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrJobNotFound):
+		return status.Error(codes.NotFound, domain.ErrJobNotFound.Error())
+	case errors.Is(err, domain.ErrVersionConflict):
+		return status.Error(codes.Aborted, domain.ErrVersionConflict.Error())
+	case errors.Is(err, domain.ErrInvalidRequest):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, domain.ErrInternalServer.Error())
+	}
+}
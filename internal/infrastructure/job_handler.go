@@ -1,10 +1,16 @@
 package infrastructure
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"job-tracker/internal/application"
 	"job-tracker/internal/domain"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -19,37 +25,40 @@ func NewJobHandler(s *application.JobService, logger domain.Logger) *JobHandler
 	return &JobHandler{service: s, logger: logger}
 }
 
-func (h *JobHandler) RegisterRoutes(r *gin.Engine) {
+func (h *JobHandler) RegisterRoutes(r gin.IRouter) {
 	r.GET("/jobs", h.GetJobs)
 	r.GET("/jobs/:id", h.GetJob)
 	r.POST("/jobs", h.CreateJob)
 	r.PUT("/jobs", h.UpdateJob)
+	r.PATCH("/jobs/:id", h.PatchJob)
+	r.PUT("/jobs/:id/status", h.UpdateJobStatus)
 	r.DELETE("/jobs/:id", h.DeleteJob)
+	r.POST("/jobs/bulk", h.Bulk)
 	r.GET("/jobs/status/:status", h.GetJobsByStatus)
 }
 
 func (h *JobHandler) GetJobs(c *gin.Context) {
 	h.logger.Info(c.Request.Context(), "getting all jobs")
-	jobs, err := h.service.GetAllJobs(c.Request.Context())
+	jobs, err := h.service.GetAllJobs(includeArchived(c), readContext(c))
 	isError := hasError(err, c)
 	if isError {
 		h.logger.Error(c.Request.Context(), "failed to get all jobs", err)
 		return
 	}
-	c.JSON(http.StatusOK, jobs)
+	writeCacheable(c, jobs)
 }
 
 func (h *JobHandler) GetJobsByStatus(c *gin.Context) {
 	h.logger.Info(c.Request.Context(), "getting jobs by status")
 	status := c.Param("status")
-	jobs, err := h.service.GetJobsByStatus(domain.JobStatusFromString(status), c.Request.Context())
+	jobs, err := h.service.GetJobsByStatus(domain.JobStatusFromString(status), includeArchived(c), readContext(c))
 	isError := hasError(err, c)
 	if isError {
 		h.logger.Error(c.Request.Context(), "failed to get jobs by status", err)
 		return
 	}
 	h.logger.Info(c.Request.Context(), "jobs fetched successfully")
-	c.JSON(http.StatusOK, jobs)
+	writeCacheable(c, jobs)
 }
 
 func (h *JobHandler) GetJob(c *gin.Context) {
@@ -58,13 +67,19 @@ func (h *JobHandler) GetJob(c *gin.Context) {
 	if !ok {
 		return
 	}
-	job, err := h.service.GetJob(id, c.Request.Context())
+	job, err := h.service.GetJob(id, readContext(c))
 	isError := hasError(err, c)
 	if isError {
 		h.logger.Error(c.Request.Context(), "failed to get job", err)
 		return
 	}
 	h.logger.Info(c.Request.Context(), "job fetched successfully")
+	setETag(c, job)
+	c.Header("Cache-Control", "private, no-cache")
+	if matchesETag(c.GetHeader("If-None-Match"), c.Writer.Header().Get("ETag")) {
+		c.Status(http.StatusNotModified)
+		return
+	}
 	c.JSON(http.StatusOK, job)
 }
 
@@ -74,7 +89,7 @@ func (h *JobHandler) CreateJob(c *gin.Context) {
 
 	if err := c.ShouldBindJSON(&request); err != nil {
 		h.logger.Error(c.Request.Context(), "invalid request body", err)
-		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: domain.ErrInvalidRequest.Error()})
+		bindError(c, err)
 		return
 	}
 
@@ -93,7 +108,10 @@ func (h *JobHandler) UpdateJob(c *gin.Context) {
 	var request application.UpdateJobRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
 		h.logger.Error(c.Request.Context(), "invalid request body", err)
-		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: domain.ErrInvalidRequest.Error()})
+		bindError(c, err)
+		return
+	}
+	if !bindIfMatch(c, &request.Version) {
 		return
 	}
 	job, err := h.service.UpdateJob(&request, c.Request.Context())
@@ -103,6 +121,71 @@ func (h *JobHandler) UpdateJob(c *gin.Context) {
 		return
 	}
 	h.logger.Info(c.Request.Context(), "job updated successfully")
+	setETag(c, job)
+	c.JSON(http.StatusOK, job)
+}
+
+func (h *JobHandler) PatchJob(c *gin.Context) {
+	h.logger.Info(c.Request.Context(), "patching job")
+	id, ok := parseUUID(c, c.Param("id"))
+	if !ok {
+		return
+	}
+	var request application.PatchJobRequest
+	switch c.ContentType() {
+	case "application/merge-patch+json", "application/json":
+		request.Format = application.PatchFormatMerge
+	case "application/json-patch+json":
+		request.Format = application.PatchFormatJSON
+	default:
+		writeProblem(c, newProblem(http.StatusUnsupportedMediaType, problemTypeUnsupportedMediaType,
+			"use application/merge-patch+json or application/json-patch+json"))
+		return
+	}
+	patch, err := c.GetRawData()
+	if err != nil {
+		h.logger.Error(c.Request.Context(), "invalid request body", err)
+		bindError(c, err)
+		return
+	}
+	request.Patch = patch
+	if !bindIfMatch(c, &request.Version) {
+		return
+	}
+	job, err := h.service.PatchJob(id, &request, c.Request.Context())
+	isError := hasError(err, c)
+	if isError {
+		h.logger.Error(c.Request.Context(), "failed to patch job", err)
+		return
+	}
+	h.logger.Info(c.Request.Context(), "job patched successfully")
+	setETag(c, job)
+	c.JSON(http.StatusOK, job)
+}
+
+func (h *JobHandler) UpdateJobStatus(c *gin.Context) {
+	h.logger.Info(c.Request.Context(), "updating job status")
+	id, ok := parseUUID(c, c.Param("id"))
+	if !ok {
+		return
+	}
+	var request application.UpdateJobStatusRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(c.Request.Context(), "invalid request body", err)
+		bindError(c, err)
+		return
+	}
+	if !bindIfMatch(c, &request.Version) {
+		return
+	}
+	job, err := h.service.UpdateJobStatus(id, &request, c.Request.Context())
+	isError := hasError(err, c)
+	if isError {
+		h.logger.Error(c.Request.Context(), "failed to update job status", err)
+		return
+	}
+	h.logger.Info(c.Request.Context(), "job status updated successfully")
+	setETag(c, job)
 	c.JSON(http.StatusOK, job)
 }
 
@@ -124,26 +207,134 @@ func (h *JobHandler) DeleteJob(c *gin.Context) {
 	c.JSON(http.StatusNoContent, gin.H{"message": "Job deleted successfully"})
 }
 
+type bulkItemResponse struct {
+	Index  int                           `json:"index"`
+	Op     application.BulkOperationKind `json:"op"`
+	Status int                           `json:"status"`
+	Job    *domain.Job                   `json:"job,omitempty"`
+	Error  *domain.Problem               `json:"error,omitempty"`
+}
+
+// Bulk answers 200 when every operation succeeded and 207 otherwise, with
+// each item's status mapped the same way as a single request.
+func (h *JobHandler) Bulk(c *gin.Context) {
+	h.logger.Info(c.Request.Context(), "running bulk job operations")
+	var request application.BulkJobsRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(c.Request.Context(), "invalid request body", err)
+		bindError(c, err)
+		return
+	}
+	if request.Mode == "" {
+		request.Mode = application.BulkModeAtomic
+	}
+	results, err := h.service.Bulk(&request, c.Request.Context())
+	isError := hasError(err, c)
+	if isError {
+		h.logger.Error(c.Request.Context(), "failed to run bulk job operations", err)
+		return
+	}
+	status := http.StatusOK
+	items := make([]bulkItemResponse, len(results))
+	for i, result := range results {
+		items[i] = bulkItemResponse{Index: result.Index, Op: result.Op, Status: http.StatusOK, Job: result.Job}
+		switch {
+		case result.Err != nil:
+			problem := problemFor(result.Err)
+			items[i].Status, items[i].Error = problem.Status, &problem
+			status = http.StatusMultiStatus
+		case result.Op == application.BulkOperationCreate:
+			items[i].Status = http.StatusCreated
+		case result.Op == application.BulkOperationDelete:
+			items[i].Status = http.StatusNoContent
+		}
+	}
+	h.logger.Info(c.Request.Context(), "bulk job operations finished")
+	c.JSON(status, items)
+}
+
 func parseUUID(c *gin.Context, idStr string) (uuid.UUID, bool) {
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: domain.ErrInvalidRequest.Error()})
+		invalidRequest(c, "id must be a valid UUID")
 		return uuid.Nil, false
 	}
 	return id, true
 }
 
-func hasError(err error, c *gin.Context) bool {
+func includeArchived(c *gin.Context) bool {
+	include, _ := strconv.ParseBool(c.Query("includeArchived"))
+	return include
+}
+
+func setETag(c *gin.Context, job *domain.Job) {
+	c.Header("ETag", strconv.Quote(strconv.Itoa(job.Version)))
+}
+
+// readContext lets a request sent with Cache-Control: no-cache or no-store
+// read past the server-side job cache.
+func readContext(c *gin.Context) context.Context {
+	directives := strings.ToLower(c.GetHeader("Cache-Control"))
+	if strings.Contains(directives, "no-cache") || strings.Contains(directives, "no-store") {
+		return WithoutCache(c.Request.Context())
+	}
+	return c.Request.Context()
+}
+
+// writeCacheable writes value as JSON with an ETag derived from the body, and
+// answers 304 instead when the client already holds that representation.
+// Clients may keep the response but must revalidate before reusing it.
+func writeCacheable(c *gin.Context, value any) {
+	body, err := json.Marshal(value)
+	if err != nil {
+		writeProblem(c, problemFor(err))
+		return
+	}
+	sum := sha256.Sum256(body)
+	etag := strconv.Quote(hex.EncodeToString(sum[:8]))
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", "private, no-cache")
+	if matchesETag(c.GetHeader("If-None-Match"), etag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.Data(http.StatusOK, "application/json; charset=utf-8", body)
+}
+
+// matchesETag reports whether an If-None-Match header names etag, comparing
+// weakly as RFC 9110 requires for GET.
+func matchesETag(ifNoneMatch string, etag string) bool {
+	for candidate := range strings.SplitSeq(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// bindIfMatch copies the version from an If-Match header into version. An
+// absent header or "*" leaves it untouched; an unusable one fails with 412.
+func bindIfMatch(c *gin.Context, version *int) bool {
+	ifMatch := strings.TrimSpace(c.GetHeader("If-Match"))
+	if ifMatch == "" || ifMatch == "*" {
+		return true
+	}
+	parsed, err := strconv.Unquote(strings.TrimPrefix(ifMatch, "W/"))
 	if err == nil {
+		*version, err = strconv.Atoi(parsed)
+	}
+	if err != nil {
+		writeProblem(c, newProblem(http.StatusPreconditionFailed, problemTypeVersionConflict, "If-Match must be a quoted job version"))
 		return false
 	}
-	switch {
-	case errors.Is(err, domain.ErrJobNotFound):
-		c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: domain.ErrJobNotFound.Error()})
-	case errors.Is(err, domain.ErrInvalidRequest):
-		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: domain.ErrInvalidRequest.Error()})
-	default:
-		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: domain.ErrInternalServer.Error()})
+	return true
+}
+
+func hasError(err error, c *gin.Context) bool {
+	if err == nil {
+		return false
 	}
+	writeProblem(c, problemFor(err))
 	return true
 }
@@ -0,0 +1,71 @@
+package infrastructure
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const jobMeterName = "job-tracker/jobs"
+
+// JobMetrics counts job creations and status transitions from the outbox
+// and reports how many active jobs sit in each stage.
+type JobMetrics struct {
+	created     metric.Int64Counter
+	transitions metric.Int64Counter
+	started     time.Time
+}
+
+func NewJobMetrics(repository domain.JobRepository, logger domain.Logger, provider metric.MeterProvider) *JobMetrics {
+	meter := provider.Meter(jobMeterName)
+	// Instrument errors only come from invalid names; a no-op instrument is
+	// returned alongside them.
+	created, _ := meter.Int64Counter("job_tracker.jobs.created",
+		metric.WithDescription("Jobs created, by initial status"))
+	transitions, _ := meter.Int64Counter("job_tracker.jobs.status_transitions",
+		metric.WithDescription("Job status changes, by previous and new status"))
+	_, _ = meter.Int64ObservableGauge("job_tracker.jobs.active",
+		metric.WithDescription("Jobs that are neither archived nor deleted, by status"),
+		metric.WithInt64Callback(func(ctx context.Context, observer metric.Int64Observer) error {
+			counts, err := repository.CountByStatus(false, ctx)
+			if err != nil {
+				logger.Error(ctx, "failed to count active jobs", err)
+				return err
+			}
+			for _, status := range domain.JobStatuses {
+				observer.Observe(counts[status], metric.WithAttributes(attribute.String("job.status", string(status))))
+			}
+			return nil
+		}))
+	return &JobMetrics{
+		created:     created,
+		transitions: transitions,
+		started:     time.Now(),
+	}
+}
+
+func (m *JobMetrics) Name() string {
+	return "metrics"
+}
+
+// Handle counts events that happened while this process was running. Older
+// events are skipped so the first deploy does not replay the whole outbox
+// into the counters.
+func (m *JobMetrics) Handle(ctx context.Context, event domain.Event) error {
+	if event.OccurredAt.Before(m.started) || event.Job == nil {
+		return nil
+	}
+	switch event.Type {
+	case domain.JobCreated:
+		m.created.Add(ctx, 1, metric.WithAttributes(attribute.String("job.status", string(event.Job.Status))))
+	case domain.JobStatusChanged:
+		m.transitions.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("job.status.from", string(event.PreviousStatus)),
+			attribute.String("job.status.to", string(event.Job.Status)),
+		))
+	}
+	return nil
+}
@@ -1,7 +1,9 @@
 package infrastructure
 
 import (
+	"context"
 	"job-tracker/internal/domain"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -15,48 +17,179 @@ func NewJobRepository(db *gorm.DB) domain.JobRepository {
 		db: db,
 	}
 }
-func (r *JobRepositoryImpl) CreateJob(job *domain.Job) error {
-	return r.db.Create(job).Error
+
+func (r *JobRepositoryImpl) CreateJob(job *domain.Job, ctx context.Context) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(job).Error; err != nil {
+			return err
+		}
+		return appendToOutbox(tx, job.PullEvents()...)
+	})
+}
+
+func (r *JobRepositoryImpl) Transaction(fn func(repository domain.JobRepository) error, ctx context.Context) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&JobRepositoryImpl{db: tx})
+	})
 }
 
-func (r *JobRepositoryImpl) GetJobById(id string) (*domain.Job, error) {
+func (r *JobRepositoryImpl) GetJobById(id string, ctx context.Context) (*domain.Job, error) {
 	var job domain.Job
-	err := r.db.First(&job, "id = ?", id).Error
+	err := r.db.WithContext(ctx).First(&job, "id = ?", id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &job, nil
 }
 
-func (r *JobRepositoryImpl) GetAll() ([]*domain.Job, error) {
+func (r *JobRepositoryImpl) GetJobsByIds(ids []string, ctx context.Context) ([]*domain.Job, error) {
 	var jobs []*domain.Job
-	err := r.db.Find(&jobs).Error
+	err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&jobs).Error
 	if err != nil {
 		return nil, err
 	}
 	return jobs, nil
 }
 
-func (r *JobRepositoryImpl) UpdateJob(job *domain.Job) error {
-	return r.db.Save(job).Error
+func (r *JobRepositoryImpl) GetAll(includeArchived bool, ctx context.Context) ([]*domain.Job, error) {
+	var jobs []*domain.Job
+	err := r.scopeArchived(includeArchived, ctx).Find(&jobs).Error
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
 }
 
-func (r *JobRepositoryImpl) DeleteJob(id string) error {
-	result := r.db.Delete(&domain.Job{}, "id = ?", id)
-	if result.Error != nil {
-		return result.Error
+// UpdateJob only writes when the stored version still matches job.Version,
+// and bumps it on success. A stale copy fails with domain.ErrVersionConflict.
+func (r *JobRepositoryImpl) UpdateJob(job *domain.Job, ctx context.Context) error {
+	expected := job.Version
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		job.Version = expected + 1
+		result := tx.Model(job).
+			Where("version = ?", expected).
+			Select("*").
+			Omit("created_at").
+			Updates(job)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrVersionConflict
+		}
+		return appendToOutbox(tx, job.PullEvents()...)
+	})
+	if err != nil {
+		job.Version = expected
+	}
+	return err
+}
+
+func (r *JobRepositoryImpl) DeleteJob(id string, ctx context.Context) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var job domain.Job
+		if err := tx.Limit(1).Find(&job, "id = ?", id).Error; err != nil {
+			return err
+		}
+		result := tx.Delete(&domain.Job{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrJobNotFound
+		}
+		return appendToOutbox(tx, domain.NewEvent(domain.JobDeleted, job.Id, &job))
+	})
+}
+
+func (r *JobRepositoryImpl) GetJobsByStatus(status domain.JobStatus, includeArchived bool, ctx context.Context) ([]*domain.Job, error) {
+	var jobs []*domain.Job
+	err := r.scopeArchived(includeArchived, ctx).Where("status = ?", status).Find(&jobs).Error
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (r *JobRepositoryImpl) scopeArchived(includeArchived bool, ctx context.Context) *gorm.DB {
+	db := r.db.WithContext(ctx)
+	if includeArchived {
+		return db
+	}
+	return db.Where("archived = ?", false)
+}
+
+func (r *JobRepositoryImpl) CountByStatus(includeArchived bool, ctx context.Context) (map[domain.JobStatus]int64, error) {
+	var rows []struct {
+		Status domain.JobStatus
+		Count  int64
 	}
-	if result.RowsAffected == 0 {
-		return domain.ErrJobNotFound
+	err := r.scopeArchived(includeArchived, ctx).Model(&domain.Job{}).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	counts := make(map[domain.JobStatus]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
 }
 
-func (r *JobRepositoryImpl) GetJobsByStatus(status domain.JobStatus) ([]*domain.Job, error) {
+func (r *JobRepositoryImpl) GetDeleted(ctx context.Context) ([]*domain.Job, error) {
 	var jobs []*domain.Job
-	err := r.db.Where("status = ?", status).Find(&jobs).Error
+	err := r.db.WithContext(ctx).Unscoped().Where("deleted_at IS NOT NULL").Order("deleted_at desc").Find(&jobs).Error
 	if err != nil {
 		return nil, err
 	}
 	return jobs, nil
 }
+
+func (r *JobRepositoryImpl) RestoreJob(id string, ctx context.Context) (*domain.Job, error) {
+	var job domain.Job
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Where("deleted_at IS NOT NULL").Limit(1).Find(&job, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrJobNotFound
+		}
+		job.DeletedAt = gorm.DeletedAt{}
+		job.Version++
+		err := tx.Unscoped().Model(&job).Updates(map[string]any{
+			"deleted_at": nil,
+			"version":    job.Version,
+		}).Error
+		if err != nil {
+			return err
+		}
+		return appendToOutbox(tx, domain.NewEvent(domain.JobRestored, job.Id, &job))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// PurgeDeleted permanently removes jobs trashed before the given time, along
+// with their reminders.
+func (r *JobRepositoryImpl) PurgeDeleted(before time.Time, ctx context.Context) (int64, error) {
+	var purged int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []string
+		err := tx.Unscoped().Model(&domain.Job{}).Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Pluck("id", &ids).Error
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		if err := tx.Where("job_id IN ?", ids).Delete(&domain.Reminder{}).Error; err != nil {
+			return err
+		}
+		result := tx.Unscoped().Where("id IN ?", ids).Delete(&domain.Job{})
+		purged = result.RowsAffected
+		return result.Error
+	})
+	return purged, err
+}
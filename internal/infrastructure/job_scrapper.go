@@ -2,38 +2,103 @@ package infrastructure
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"sync"
 	"time"
 
+	"job-tracker/internal/application"
 	"job-tracker/internal/domain"
 
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/net/html"
 	"golang.org/x/sync/semaphore"
 )
 
+// ScrapeConfig sets how often postings are scraped and how many are fetched
+// at the same time.
+type ScrapeConfig struct {
+	Interval    time.Duration
+	Concurrency int
+}
+
+const scrapeInstrumentationName = "job-tracker/scrapper"
+
 type JobScrapper struct {
-	rp   domain.JobRepository
-	log  domain.Logger
-	lock chan struct{}
+	rp          domain.JobRepository
+	notifier    domain.Notifier
+	log         domain.Logger
+	config      ScrapeConfig
+	lock        chan struct{}
+	maxAttempts int
+	metrics     scrapeMetrics
+	tracer      trace.Tracer
+	client      *http.Client
 }
 
-func NewJobScrapper(rp domain.JobRepository, log domain.Logger) *JobScrapper {
+type scrapeMetrics struct {
+	attempts  metric.Int64Counter
+	successes metric.Int64Counter
+	failures  metric.Int64Counter
+	duration  metric.Float64Histogram
+	fields    metric.Int64Counter
+}
+
+func NewJobScrapper(rp domain.JobRepository, notifier domain.Notifier, log domain.Logger, config ScrapeConfig, meters metric.MeterProvider, tracer trace.TracerProvider) *JobScrapper {
 	return &JobScrapper{
-		rp:   rp,
-		log:  log,
-		lock: make(chan struct{}, 1),
+		rp:          rp,
+		notifier:    notifier,
+		log:         log,
+		config:      config,
+		lock:        make(chan struct{}, 1),
+		maxAttempts: 3,
+		metrics:     newScrapeMetrics(meters),
+		tracer:      tracer.Tracer(scrapeInstrumentationName),
+		client: &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport,
+			otelhttp.WithTracerProvider(tracer),
+			otelhttp.WithMeterProvider(meters),
+		)},
+	}
+}
+
+func newScrapeMetrics(provider metric.MeterProvider) scrapeMetrics {
+	meter := provider.Meter(scrapeInstrumentationName)
+	// Instrument errors only come from invalid names; a no-op instrument is
+	// returned alongside them.
+	attempts, _ := meter.Int64Counter("job_tracker.scrape.attempts",
+		metric.WithDescription("Postings fetched by the scrapper, by host"))
+	successes, _ := meter.Int64Counter("job_tracker.scrape.successes",
+		metric.WithDescription("Postings fetched and saved without error, by host"))
+	failures, _ := meter.Int64Counter("job_tracker.scrape.failures",
+		metric.WithDescription("Postings that could not be fetched, parsed or saved, by host"))
+	duration, _ := meter.Float64Histogram("job_tracker.scrape.duration",
+		metric.WithDescription("Time to fetch, parse and save a posting"),
+		metric.WithUnit("s"))
+	fields, _ := meter.Int64Counter("job_tracker.scrape.fields",
+		metric.WithDescription("Fields looked for in fetched postings, by field and whether it was found"))
+	return scrapeMetrics{
+		attempts:  attempts,
+		successes: successes,
+		failures:  failures,
+		duration:  duration,
+		fields:    fields,
 	}
 }
 
 func (s *JobScrapper) InitScrape(ctx context.Context) error {
 
-	ticker := time.NewTicker(30 * time.Second)
+	ticker := time.NewTicker(s.config.Interval)
 	defer ticker.Stop()
 
-	sem := semaphore.NewWeighted(20)
+	sem := semaphore.NewWeighted(int64(s.config.Concurrency))
 
 	for range ticker.C {
 		s.log.Info(ctx, "scraping jobs")
@@ -57,19 +122,24 @@ func (s *JobScrapper) InitScrape(ctx context.Context) error {
 	return nil
 }
 
-func (s *JobScrapper) scrape(sem *semaphore.Weighted, ctx context.Context) error {
+// scrape runs one sweep over the active jobs. Each sweep is the root of its
+// own trace, with a child span per scraped posting.
+func (s *JobScrapper) scrape(sem *semaphore.Weighted, ctx context.Context) (err error) {
+	ctx, span := s.tracer.Start(ctx, "JobScrapper.scrape", trace.WithNewRoot())
+	defer func() { application.EndSpan(span, err) }()
 
-	jobs, err := s.rp.GetAll()
+	jobs, err := s.rp.GetAll(false, ctx)
 	if err != nil {
 		s.log.Error(ctx, "error getting jobs", err)
 		return err
 	}
+	span.SetAttributes(attribute.Int("job.count", len(jobs)))
 
 	var wg sync.WaitGroup
 
 	for _, job := range jobs {
 		wg.Add(1)
-		if err := sem.Acquire(ctx, 1); err != nil {
+		if err = sem.Acquire(ctx, 1); err != nil {
 			s.log.Error(ctx, "semaphore error", err)
 			return err
 		}
@@ -80,6 +150,7 @@ func (s *JobScrapper) scrape(sem *semaphore.Weighted, ctx context.Context) error
 
 			if err := s.run(job, ctx); err != nil {
 				s.log.Error(ctx, "job failed", err)
+				s.notify(domain.NotificationScrapeFailed, "Scrape failed: "+job.Position+" at "+job.Company, err.Error(), job, ctx)
 			}
 		}(job)
 	}
@@ -88,13 +159,42 @@ func (s *JobScrapper) scrape(sem *semaphore.Weighted, ctx context.Context) error
 	return nil
 }
 
-func (s *JobScrapper) run(job *domain.Job, ctx context.Context) error {
+// run scrapes one posting and records the attempt, its outcome and how long
+// it took against the posting's host.
+func (s *JobScrapper) run(job *domain.Job, ctx context.Context) (err error) {
 
 	if job.Url == "" {
 		return nil
 	}
 
-	resp, err := http.Get(job.Url)
+	host := attribute.String("server.address", postingHost(job.Url))
+	ctx, span := s.tracer.Start(ctx, "JobScrapper.run", trace.WithAttributes(attribute.String("job.id", job.Id.String()), host))
+	defer func() { application.EndSpan(span, err) }()
+
+	s.metrics.attempts.Add(ctx, 1, metric.WithAttributes(host))
+	start := time.Now()
+
+	err = s.fetch(job, ctx)
+
+	outcome := "success"
+	if err != nil {
+		outcome = "failure"
+		s.metrics.failures.Add(ctx, 1, metric.WithAttributes(host))
+	} else {
+		s.metrics.successes.Add(ctx, 1, metric.WithAttributes(host))
+	}
+	s.metrics.duration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(host, attribute.String("outcome", outcome)))
+	return err
+}
+
+func (s *JobScrapper) fetch(job *domain.Job, ctx context.Context) error {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, job.Url, nil)
+	if err != nil {
+		s.log.Error(ctx, "error building HTTP request", err)
+		return err
+	}
+	resp, err := s.client.Do(req)
 	if err != nil {
 		s.log.Error(ctx, "error making HTTP request", err)
 		return err
@@ -105,8 +205,11 @@ func (s *JobScrapper) run(job *domain.Job, ctx context.Context) error {
 		}
 	}()
 
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
 	if resp.StatusCode != http.StatusOK {
-		s.log.Error(ctx, "non-OK HTTP status", nil)
+		err = fmt.Errorf("unexpected HTTP status %d", resp.StatusCode)
+		s.log.Error(ctx, "non-OK HTTP status", err)
 		return err
 	}
 
@@ -115,6 +218,7 @@ func (s *JobScrapper) run(job *domain.Job, ctx context.Context) error {
 		s.log.Error(ctx, "error reading response body", err)
 		return err
 	}
+	span.SetAttributes(semconv.HTTPResponseBodySize(len(body)))
 
 	doc, err := html.Parse(strings.NewReader(string(body)))
 	if err != nil {
@@ -124,25 +228,78 @@ func (s *JobScrapper) run(job *domain.Job, ctx context.Context) error {
 
 	extractedInfo := s.extractJobInfo(doc)
 	extractedStatus := s.extractJobStatus(doc)
+	s.recordField(domain.JobFieldDescription, extractedInfo != "", ctx)
+	s.recordField(domain.JobFieldStatus, extractedStatus != "", ctx)
 
 	if extractedStatus == "" && extractedInfo == "" {
 		return nil
 	}
 
-	if extractedInfo != "" {
-		job.Description = extractedInfo
+	var status domain.JobStatus
+	if extractedStatus != "" {
+		status = domain.JobStatusFromString(extractedStatus)
 	}
 
-	if extractedStatus != "" {
-		job.Status = domain.JobStatusFromString(extractedStatus)
+	return s.save(job, extractedInfo, status, ctx)
+}
+
+// save applies the scraped values with compare-and-swap. When a user edited
+// the job since it was loaded, the fresh copy is re-read and the scraped
+// values are applied on top of it instead of overwriting the edit.
+func (s *JobScrapper) save(job *domain.Job, description string, status domain.JobStatus, ctx context.Context) error {
+	for attempt := 1; ; attempt++ {
+		closed := status == domain.JobStatusClosed && job.PostingClosedAt == nil
+		if !job.ApplyScraped(description, status) {
+			return nil
+		}
+
+		err := s.rp.UpdateJob(job, ctx)
+		if err == nil {
+			if closed {
+				s.notify(domain.NotificationPostingClosed, "Posting closed: "+job.Position+" at "+job.Company, job.Url, job, ctx)
+			}
+			return nil
+		}
+		if !errors.Is(err, domain.ErrVersionConflict) || attempt == s.maxAttempts {
+			s.log.Error(ctx, "error updating job", err)
+			return err
+		}
+
+		s.log.Info(ctx, "job changed while scraping, retrying", domain.Field{Key: "job_id", Value: job.Id.String()})
+		job, err = s.rp.GetJobById(job.Id.String(), ctx)
+		if err != nil {
+			s.log.Error(ctx, "error reloading job", err)
+			return err
+		}
 	}
+}
 
-	err = s.rp.UpdateJob(job)
-	if err != nil {
-		s.log.Error(ctx, "error updating job", err)
-		return err
+func (s *JobScrapper) recordField(field string, extracted bool, ctx context.Context) {
+	s.metrics.fields.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("field", field),
+		attribute.Bool("extracted", extracted),
+	))
+}
+
+func postingHost(posting string) string {
+	parsed, err := url.Parse(posting)
+	if err != nil || parsed.Hostname() == "" {
+		return "unknown"
+	}
+	return parsed.Hostname()
+}
+
+func (s *JobScrapper) notify(event domain.NotificationEvent, subject string, message string, job *domain.Job, ctx context.Context) {
+	notification := domain.Notification{
+		Event:   event,
+		Subject: subject,
+		Message: message,
+		JobId:   job.Id,
+		UserId:  job.UserId,
+	}
+	if err := s.notifier.Notify(ctx, notification); err != nil {
+		s.log.Error(ctx, "error sending notification", err)
 	}
-	return nil
 }
 
 func (s *JobScrapper) extractJobInfo(n *html.Node) string {
@@ -0,0 +1,15 @@
+-- The jobs table as the first release created it with AutoMigrate. Databases
+-- from that release already have it and are adopted as version 1.
+CREATE TABLE IF NOT EXISTS "jobs" (
+    "id" uuid,
+    "company" text,
+    "position" text,
+    "description" text,
+    "status" text,
+    "salary" bigint,
+    "remote" boolean,
+    "url" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
@@ -0,0 +1,107 @@
+-- Columns and tables added after the first release. Every statement is
+-- idempotent, so databases that AutoMigrate already brought up to date are
+-- adopted as they are.
+ALTER TABLE "jobs" ADD COLUMN IF NOT EXISTS "notes" text;
+ALTER TABLE "jobs" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "jobs" ADD COLUMN IF NOT EXISTS "archived" boolean NOT NULL DEFAULT false;
+ALTER TABLE "jobs" ADD COLUMN IF NOT EXISTS "archived_at" timestamptz;
+ALTER TABLE "jobs" ADD COLUMN IF NOT EXISTS "status_changed_at" timestamptz;
+ALTER TABLE "jobs" ADD COLUMN IF NOT EXISTS "tags" text;
+ALTER TABLE "jobs" ADD COLUMN IF NOT EXISTS "field_sources" text;
+ALTER TABLE "jobs" ADD COLUMN IF NOT EXISTS "deleted_at" timestamptz;
+CREATE INDEX IF NOT EXISTS "idx_jobs_deleted_at" ON "jobs" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_jobs_archived" ON "jobs" ("archived");
+
+CREATE TABLE IF NOT EXISTS "reminders" (
+    "id" uuid,
+    "job_id" uuid,
+    "due_at" timestamptz,
+    "message" text,
+    "recurrence" text,
+    "fired_at" timestamptz,
+    "done" boolean,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_reminders_due_at" ON "reminders" ("due_at");
+CREATE INDEX IF NOT EXISTS "idx_reminders_job_id" ON "reminders" ("job_id");
+
+CREATE TABLE IF NOT EXISTS "notification_channels" (
+    "id" uuid,
+    "user_id" text,
+    "kind" text,
+    "target" text,
+    "secret" text,
+    "events" text,
+    "enabled" boolean,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_notification_channels_user_id" ON "notification_channels" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "notification_deliveries" (
+    "id" uuid,
+    "channel_id" uuid,
+    "event" text,
+    "subject" text,
+    "status" text,
+    "attempts" bigint,
+    "last_error" text,
+    "created_at" timestamptz,
+    "delivered_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_notification_deliveries_channel_id" ON "notification_deliveries" ("channel_id");
+
+CREATE TABLE IF NOT EXISTS "webhook_subscriptions" (
+    "id" uuid,
+    "url" text,
+    "secret" text,
+    "events" text,
+    "active" boolean,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "webhook_deliveries" (
+    "id" uuid,
+    "subscription_id" uuid,
+    "event_id" uuid,
+    "event_type" text,
+    "payload" text,
+    "status" text,
+    "attempts" bigint,
+    "next_attempt_at" timestamptz,
+    "response_status" bigint,
+    "last_error" text,
+    "delivered_at" timestamptz,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_webhook_deliveries_next_attempt_at" ON "webhook_deliveries" ("next_attempt_at");
+CREATE INDEX IF NOT EXISTS "idx_webhook_deliveries_status" ON "webhook_deliveries" ("status");
+CREATE INDEX IF NOT EXISTS "idx_webhook_deliveries_subscription_id" ON "webhook_deliveries" ("subscription_id");
+
+CREATE TABLE IF NOT EXISTS "outbox_messages" (
+    "sequence" bigserial,
+    "event_id" uuid,
+    "type" text,
+    "job_id" uuid,
+    "payload" text,
+    "occurred_at" timestamptz,
+    PRIMARY KEY ("sequence")
+);
+CREATE INDEX IF NOT EXISTS "idx_outbox_messages_job_id" ON "outbox_messages" ("job_id");
+CREATE INDEX IF NOT EXISTS "idx_outbox_messages_type" ON "outbox_messages" ("type");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_outbox_messages_event_id" ON "outbox_messages" ("event_id");
+
+CREATE TABLE IF NOT EXISTS "outbox_checkpoints" (
+    "subscriber" text,
+    "sequence" bigint,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("subscriber")
+);
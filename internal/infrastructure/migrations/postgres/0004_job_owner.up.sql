@@ -0,0 +1,3 @@
+-- Jobs can belong to a user, so event streams can be filtered per user.
+ALTER TABLE "jobs" ADD COLUMN IF NOT EXISTS "user_id" text;
+CREATE INDEX IF NOT EXISTS "idx_jobs_user_id" ON "jobs" ("user_id");
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "idx_notification_deliveries_next_attempt_at";
+DROP INDEX IF EXISTS "idx_notification_deliveries_status";
+ALTER TABLE "notification_deliveries" DROP COLUMN IF EXISTS "next_attempt_at";
+ALTER TABLE "notification_deliveries" DROP COLUMN IF EXISTS "message";
+ALTER TABLE "notification_deliveries" DROP COLUMN IF EXISTS "job_id";
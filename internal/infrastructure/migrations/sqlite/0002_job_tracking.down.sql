@@ -0,0 +1,17 @@
+DROP TABLE IF EXISTS `outbox_checkpoints`;
+DROP TABLE IF EXISTS `outbox_messages`;
+DROP TABLE IF EXISTS `webhook_deliveries`;
+DROP TABLE IF EXISTS `webhook_subscriptions`;
+DROP TABLE IF EXISTS `notification_deliveries`;
+DROP TABLE IF EXISTS `notification_channels`;
+DROP TABLE IF EXISTS `reminders`;
+DROP INDEX IF EXISTS `idx_jobs_archived`;
+DROP INDEX IF EXISTS `idx_jobs_deleted_at`;
+ALTER TABLE `jobs` DROP COLUMN `deleted_at`;
+ALTER TABLE `jobs` DROP COLUMN `field_sources`;
+ALTER TABLE `jobs` DROP COLUMN `tags`;
+ALTER TABLE `jobs` DROP COLUMN `status_changed_at`;
+ALTER TABLE `jobs` DROP COLUMN `archived_at`;
+ALTER TABLE `jobs` DROP COLUMN `archived`;
+ALTER TABLE `jobs` DROP COLUMN `version`;
+ALTER TABLE `jobs` DROP COLUMN `notes`;
@@ -0,0 +1,106 @@
+-- Columns and tables added after the first release. Every statement is
+-- idempotent, so databases that AutoMigrate already brought up to date are
+-- adopted as they are.
+ALTER TABLE `jobs` ADD COLUMN IF NOT EXISTS `notes` text;
+ALTER TABLE `jobs` ADD COLUMN IF NOT EXISTS `version` integer NOT NULL DEFAULT 1;
+ALTER TABLE `jobs` ADD COLUMN IF NOT EXISTS `archived` numeric NOT NULL DEFAULT false;
+ALTER TABLE `jobs` ADD COLUMN IF NOT EXISTS `archived_at` datetime;
+ALTER TABLE `jobs` ADD COLUMN IF NOT EXISTS `status_changed_at` datetime;
+ALTER TABLE `jobs` ADD COLUMN IF NOT EXISTS `tags` text;
+ALTER TABLE `jobs` ADD COLUMN IF NOT EXISTS `field_sources` text;
+ALTER TABLE `jobs` ADD COLUMN IF NOT EXISTS `deleted_at` datetime;
+CREATE INDEX IF NOT EXISTS `idx_jobs_deleted_at` ON `jobs` (`deleted_at`);
+CREATE INDEX IF NOT EXISTS `idx_jobs_archived` ON `jobs` (`archived`);
+
+CREATE TABLE IF NOT EXISTS `reminders` (
+    `id` uuid,
+    `job_id` uuid,
+    `due_at` datetime,
+    `message` text,
+    `recurrence` text,
+    `fired_at` datetime,
+    `done` numeric,
+    `created_at` datetime,
+    `updated_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX IF NOT EXISTS `idx_reminders_due_at` ON `reminders` (`due_at`);
+CREATE INDEX IF NOT EXISTS `idx_reminders_job_id` ON `reminders` (`job_id`);
+
+CREATE TABLE IF NOT EXISTS `notification_channels` (
+    `id` uuid,
+    `user_id` text,
+    `kind` text,
+    `target` text,
+    `secret` text,
+    `events` text,
+    `enabled` numeric,
+    `created_at` datetime,
+    `updated_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX IF NOT EXISTS `idx_notification_channels_user_id` ON `notification_channels` (`user_id`);
+
+CREATE TABLE IF NOT EXISTS `notification_deliveries` (
+    `id` uuid,
+    `channel_id` uuid,
+    `event` text,
+    `subject` text,
+    `status` text,
+    `attempts` integer,
+    `last_error` text,
+    `created_at` datetime,
+    `delivered_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX IF NOT EXISTS `idx_notification_deliveries_channel_id` ON `notification_deliveries` (`channel_id`);
+
+CREATE TABLE IF NOT EXISTS `webhook_subscriptions` (
+    `id` uuid,
+    `url` text,
+    `secret` text,
+    `events` text,
+    `active` numeric,
+    `created_at` datetime,
+    `updated_at` datetime,
+    PRIMARY KEY (`id`)
+);
+
+CREATE TABLE IF NOT EXISTS `webhook_deliveries` (
+    `id` uuid,
+    `subscription_id` uuid,
+    `event_id` uuid,
+    `event_type` text,
+    `payload` text,
+    `status` text,
+    `attempts` integer,
+    `next_attempt_at` datetime,
+    `response_status` integer,
+    `last_error` text,
+    `delivered_at` datetime,
+    `created_at` datetime,
+    `updated_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX IF NOT EXISTS `idx_webhook_deliveries_next_attempt_at` ON `webhook_deliveries` (`next_attempt_at`);
+CREATE INDEX IF NOT EXISTS `idx_webhook_deliveries_status` ON `webhook_deliveries` (`status`);
+CREATE INDEX IF NOT EXISTS `idx_webhook_deliveries_subscription_id` ON `webhook_deliveries` (`subscription_id`);
+
+CREATE TABLE IF NOT EXISTS `outbox_messages` (
+    `sequence` integer PRIMARY KEY AUTOINCREMENT,
+    `event_id` uuid,
+    `type` text,
+    `job_id` uuid,
+    `payload` text,
+    `occurred_at` datetime
+);
+CREATE INDEX IF NOT EXISTS `idx_outbox_messages_job_id` ON `outbox_messages` (`job_id`);
+CREATE INDEX IF NOT EXISTS `idx_outbox_messages_type` ON `outbox_messages` (`type`);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_outbox_messages_event_id` ON `outbox_messages` (`event_id`);
+
+CREATE TABLE IF NOT EXISTS `outbox_checkpoints` (
+    `subscriber` text,
+    `sequence` integer,
+    `updated_at` datetime,
+    PRIMARY KEY (`subscriber`)
+);
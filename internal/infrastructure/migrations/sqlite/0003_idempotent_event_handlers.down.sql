@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS `idx_webhook_deliveries_subscription_event`;
+DROP INDEX IF EXISTS `idx_reminders_job_event`;
+ALTER TABLE `reminders` DROP COLUMN `event_id`;
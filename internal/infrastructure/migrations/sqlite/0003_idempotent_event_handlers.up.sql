@@ -0,0 +1,10 @@
+-- Reminders and webhook deliveries remember the event that created them, so
+-- a redelivered outbox event inserts nothing new. Duplicates queued before
+-- the unique index existed are dropped first, keeping the oldest row.
+ALTER TABLE `reminders` ADD COLUMN IF NOT EXISTS `event_id` uuid;
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_reminders_job_event` ON `reminders` (`job_id`, `event_id`);
+
+DELETE FROM `webhook_deliveries` WHERE rowid NOT IN (
+    SELECT MIN(rowid) FROM `webhook_deliveries` GROUP BY `subscription_id`, `event_id`
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_webhook_deliveries_subscription_event` ON `webhook_deliveries` (`subscription_id`, `event_id`);
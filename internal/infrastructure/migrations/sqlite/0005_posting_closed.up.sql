@@ -0,0 +1,2 @@
+-- When the scraper found the posting closed, kept apart from the status.
+ALTER TABLE `jobs` ADD COLUMN IF NOT EXISTS `posting_closed_at` datetime;
@@ -0,0 +1,7 @@
+-- Notification deliveries are queued and retried in the background, so they
+-- keep what the sender needs and when to try next.
+ALTER TABLE `notification_deliveries` ADD COLUMN IF NOT EXISTS `job_id` uuid;
+ALTER TABLE `notification_deliveries` ADD COLUMN IF NOT EXISTS `message` text;
+ALTER TABLE `notification_deliveries` ADD COLUMN IF NOT EXISTS `next_attempt_at` datetime;
+CREATE INDEX IF NOT EXISTS `idx_notification_deliveries_status` ON `notification_deliveries` (`status`);
+CREATE INDEX IF NOT EXISTS `idx_notification_deliveries_next_attempt_at` ON `notification_deliveries` (`next_attempt_at`);
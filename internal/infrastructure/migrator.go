@@ -0,0 +1,291 @@
+package infrastructure
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+//go:embed migrations
+var migrationsFS embed.FS
+
+// migrationLockKey identifies the Postgres advisory lock held while migrating.
+const migrationLockKey = 4_417_202_201
+
+var ErrDirtySchema = errors.New("schema is dirty")
+
+// sqliteAddColumn matches a one-line ADD COLUMN IF NOT EXISTS, which SQLite
+// does not support.
+var sqliteAddColumn = regexp.MustCompile("(?im)^ALTER TABLE [`\"]?(\\w+)[`\"]? ADD COLUMN IF NOT EXISTS [`\"]?(\\w+)[`\"]?.*$")
+
+// Migration is one versioned schema change. Files are embedded from
+// migrations/<dialect>/<version>_<name>.up.sql and .down.sql.
+type Migration struct {
+	Version int
+	Name    string
+	up      string
+	down    string
+}
+
+type MigrationState struct {
+	Version int
+	Name    string
+	Applied bool
+}
+
+type SchemaStatus struct {
+	Version    int
+	Dirty      bool
+	Migrations []MigrationState
+}
+
+// Migrator applies the embedded migrations for the dialect of db and records
+// the current version in schema_migrations. A migration that fails leaves the
+// schema marked dirty; nothing runs again until it is repaired and forced.
+type Migrator struct {
+	db         *gorm.DB
+	dialect    string
+	migrations []Migration
+}
+
+func NewMigrator(db *gorm.DB) (*Migrator, error) {
+	dialect := db.Dialector.Name()
+	migrations, err := loadMigrations(dialect)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, dialect: dialect, migrations: migrations}, nil
+}
+
+func loadMigrations(dialect string) ([]Migration, error) {
+	dir := "migrations/" + dialect
+	entries, err := fs.ReadDir(migrationsFS, dir)
+	if err != nil {
+		return nil, fmt.Errorf("no migrations for database %q", dialect)
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		base, up := strings.CutSuffix(entry.Name(), ".up.sql")
+		if !up {
+			var down bool
+			if base, down = strings.CutSuffix(entry.Name(), ".down.sql"); !down {
+				return nil, fmt.Errorf("unexpected migration file %s/%s", dir, entry.Name())
+			}
+		}
+		number, name, _ := strings.Cut(base, "_")
+		version, err := strconv.Atoi(number)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("migration file %s/%s has no version", dir, entry.Name())
+		}
+		sql, err := fs.ReadFile(migrationsFS, dir+"/"+entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		}
+		if up {
+			migration.up = string(sql)
+		} else {
+			migration.down = string(sql)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.up == "" || migration.down == "" {
+			return nil, fmt.Errorf("migration %d in %s needs both an up and a down file", migration.Version, dir)
+		}
+		migrations = append(migrations, *migration)
+	}
+	slices.SortFunc(migrations, func(a, b Migration) int { return a.Version - b.Version })
+	return migrations, nil
+}
+
+// Up applies every pending migration.
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.locked(ctx, func(conn *gorm.DB) error {
+		version, err := checkClean(conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			if migration.Version <= version {
+				continue
+			}
+			if err := step(conn, migration.Version, migration.Version, migration.up); err != nil {
+				return fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Down rolls back the last steps applied migrations.
+func (m *Migrator) Down(steps int, ctx context.Context) error {
+	return m.locked(ctx, func(conn *gorm.DB) error {
+		version, err := checkClean(conn)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && steps > 0; i-- {
+			migration := m.migrations[i]
+			if migration.Version > version {
+				continue
+			}
+			previous := 0
+			if i > 0 {
+				previous = m.migrations[i-1].Version
+			}
+			if err := step(conn, migration.Version, previous, migration.down); err != nil {
+				return fmt.Errorf("rolling back migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+			steps--
+		}
+		return nil
+	})
+}
+
+// Force records version as the current, clean version without running
+// anything. It is how a dirty schema is released once it has been repaired.
+func (m *Migrator) Force(version int, ctx context.Context) error {
+	if version != 0 && !slices.ContainsFunc(m.migrations, func(migration Migration) bool { return migration.Version == version }) {
+		return fmt.Errorf("unknown migration version %d", version)
+	}
+	return m.locked(ctx, func(conn *gorm.DB) error {
+		return writeVersion(conn, version, false)
+	})
+}
+
+func (m *Migrator) Status(ctx context.Context) (*SchemaStatus, error) {
+	conn := m.db.WithContext(ctx)
+	if err := createVersionTable(conn); err != nil {
+		return nil, err
+	}
+	version, dirty, err := readVersion(conn)
+	if err != nil {
+		return nil, err
+	}
+	status := &SchemaStatus{Version: version, Dirty: dirty}
+	for _, migration := range m.migrations {
+		status.Migrations = append(status.Migrations, MigrationState{
+			Version: migration.Version,
+			Name:    migration.Name,
+			Applied: migration.Version <= version,
+		})
+	}
+	return status, nil
+}
+
+// locked runs fn while holding the migration lock. On Postgres that is a
+// session advisory lock, so replicas booting together migrate one at a time.
+// SQLite has a single writer, so fn runs in one write transaction; each
+// migration then runs in a savepoint and the dirty mark survives a failure.
+func (m *Migrator) locked(ctx context.Context, fn func(conn *gorm.DB) error) error {
+	db := m.db.WithContext(ctx)
+	if m.dialect == "postgres" {
+		return db.Connection(func(conn *gorm.DB) error {
+			if err := conn.Exec("SELECT pg_advisory_lock(?)", migrationLockKey).Error; err != nil {
+				return err
+			}
+			defer conn.Exec("SELECT pg_advisory_unlock(?)", migrationLockKey)
+			if err := createVersionTable(conn); err != nil {
+				return err
+			}
+			return fn(conn)
+		})
+	}
+
+	var err error
+	txErr := db.Transaction(func(tx *gorm.DB) error {
+		err = createVersionTable(tx)
+		if err == nil {
+			err = fn(tx)
+		}
+		return nil
+	})
+	return errors.Join(txErr, err)
+}
+
+// step marks the schema dirty at version, runs sql and marks it clean at
+// target.
+func step(conn *gorm.DB, version int, target int, sql string) error {
+	if err := writeVersion(conn, version, true); err != nil {
+		return err
+	}
+	err := conn.Transaction(func(tx *gorm.DB) error {
+		if tx.Dialector.Name() == "sqlite" {
+			sql = addMissingColumns(tx, sql)
+		}
+		return tx.Exec(sql).Error
+	})
+	if err != nil {
+		return err
+	}
+	return writeVersion(conn, target, false)
+}
+
+// addMissingColumns rewrites each ADD COLUMN IF NOT EXISTS for SQLite: it is
+// dropped when the column exists and run without the clause otherwise.
+func addMissingColumns(tx *gorm.DB, sql string) string {
+	return sqliteAddColumn.ReplaceAllStringFunc(sql, func(statement string) string {
+		match := sqliteAddColumn.FindStringSubmatch(statement)
+		if tx.Migrator().HasColumn(match[1], match[2]) {
+			return ""
+		}
+		return strings.Replace(statement, " IF NOT EXISTS", "", 1)
+	})
+}
+
+func checkClean(conn *gorm.DB) (int, error) {
+	version, dirty, err := readVersion(conn)
+	if err != nil {
+		return 0, err
+	}
+	if dirty {
+		return 0, fmt.Errorf("%w at version %d: repair it and run migrate force", ErrDirtySchema, version)
+	}
+	return version, nil
+}
+
+func createVersionTable(conn *gorm.DB) error {
+	return conn.Exec("CREATE TABLE IF NOT EXISTS schema_migrations (version bigint NOT NULL PRIMARY KEY, dirty boolean NOT NULL)").Error
+}
+
+func readVersion(conn *gorm.DB) (int, bool, error) {
+	var rows []struct {
+		Version int
+		Dirty   bool
+	}
+	err := conn.Raw("SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&rows).Error
+	if err != nil || len(rows) == 0 {
+		return 0, false, err
+	}
+	return rows[0].Version, rows[0].Dirty, nil
+}
+
+// writeVersion replaces the single row of schema_migrations. A clean version
+// 0 is an empty table.
+func writeVersion(conn *gorm.DB, version int, dirty bool) error {
+	return conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM schema_migrations").Error; err != nil {
+			return err
+		}
+		if version == 0 && !dirty {
+			return nil
+		}
+		return tx.Exec("INSERT INTO schema_migrations (version, dirty) VALUES (?, ?)", version, dirty).Error
+	})
+}
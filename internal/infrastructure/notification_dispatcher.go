@@ -0,0 +1,40 @@
+package infrastructure
+
+import (
+	"context"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"time"
+)
+
+type NotificationDispatcher struct {
+	service  *application.NotificationService
+	log      domain.Logger
+	interval time.Duration
+}
+
+func NewNotificationDispatcher(service *application.NotificationService, log domain.Logger) *NotificationDispatcher {
+	return &NotificationDispatcher{
+		service:  service,
+		log:      log,
+		interval: 5 * time.Second,
+	}
+}
+
+func (d *NotificationDispatcher) InitDispatch(ctx context.Context) error {
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			if err := d.service.DispatchDue(now, ctx); err != nil {
+				d.log.Error(ctx, "error dispatching notifications", err)
+			}
+		case <-ctx.Done():
+			d.log.Info(ctx, "notification dispatcher stopped")
+			return nil
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package infrastructure
+
+import (
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type NotificationHandler struct {
+	service *application.NotificationService
+	logger  domain.Logger
+}
+
+func NewNotificationHandler(s *application.NotificationService, logger domain.Logger) *NotificationHandler {
+	return &NotificationHandler{service: s, logger: logger}
+}
+
+func (h *NotificationHandler) RegisterRoutes(r gin.IRouter) {
+	r.GET("/users/:user/channels", h.GetChannels)
+	r.POST("/users/:user/channels", h.CreateChannel)
+	r.PUT("/users/:user/channels/:id", h.UpdateChannel)
+	r.DELETE("/users/:user/channels/:id", h.DeleteChannel)
+	r.GET("/users/:user/channels/:id/deliveries", h.GetDeliveries)
+}
+
+func (h *NotificationHandler) GetChannels(c *gin.Context) {
+	h.logger.Info(c.Request.Context(), "getting notification channels")
+	channels, err := h.service.GetChannels(c.Param("user"), c.Request.Context())
+	isError := hasError(err, c)
+	if isError {
+		h.logger.Error(c.Request.Context(), "failed to get notification channels", err)
+		return
+	}
+	c.JSON(http.StatusOK, channels)
+}
+
+func (h *NotificationHandler) CreateChannel(c *gin.Context) {
+	h.logger.Info(c.Request.Context(), "creating notification channel")
+	var request application.CreateChannelRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(c.Request.Context(), "invalid request body", err)
+		bindError(c, err)
+		return
+	}
+
+	channel, err := h.service.CreateChannel(c.Param("user"), &request, c.Request.Context())
+	isError := hasError(err, c)
+	if isError {
+		h.logger.Error(c.Request.Context(), "failed to create notification channel", err)
+		return
+	}
+	h.logger.Info(c.Request.Context(), "notification channel created successfully")
+	c.JSON(http.StatusCreated, channel)
+}
+
+func (h *NotificationHandler) UpdateChannel(c *gin.Context) {
+	h.logger.Info(c.Request.Context(), "updating notification channel")
+	id, ok := parseUUID(c, c.Param("id"))
+	if !ok {
+		return
+	}
+	var request application.UpdateChannelRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(c.Request.Context(), "invalid request body", err)
+		bindError(c, err)
+		return
+	}
+	channel, err := h.service.UpdateChannel(c.Param("user"), id.String(), &request, c.Request.Context())
+	isError := hasError(err, c)
+	if isError {
+		h.logger.Error(c.Request.Context(), "failed to update notification channel", err)
+		return
+	}
+	h.logger.Info(c.Request.Context(), "notification channel updated successfully")
+	c.JSON(http.StatusOK, channel)
+}
+
+func (h *NotificationHandler) DeleteChannel(c *gin.Context) {
+	h.logger.Info(c.Request.Context(), "deleting notification channel")
+	id, ok := parseUUID(c, c.Param("id"))
+	if !ok {
+		return
+	}
+	err := h.service.DeleteChannel(c.Param("user"), id.String(), c.Request.Context())
+	isError := hasError(err, c)
+	if isError {
+		h.logger.Error(c.Request.Context(), "failed to delete notification channel", err)
+		return
+	}
+	h.logger.Info(c.Request.Context(), "notification channel deleted successfully")
+	c.Status(http.StatusNoContent)
+}
+
+func (h *NotificationHandler) GetDeliveries(c *gin.Context) {
+	h.logger.Info(c.Request.Context(), "getting notification deliveries")
+	id, ok := parseUUID(c, c.Param("id"))
+	if !ok {
+		return
+	}
+	deliveries, err := h.service.GetDeliveries(c.Param("user"), id.String(), c.Request.Context())
+	isError := hasError(err, c)
+	if isError {
+		h.logger.Error(c.Request.Context(), "failed to get notification deliveries", err)
+		return
+	}
+	c.JSON(http.StatusOK, deliveries)
+}
+
+// validateChannelTarget checks the target against the channel kind: an email
+// address for EMAIL, an http or https URL for SLACK and WEBHOOK.
+func validateChannelTarget(sl validator.StructLevel) {
+	request := sl.Current().Interface().(application.CreateChannelRequest)
+	rule := "http_url"
+	if domain.ChannelKind(request.Kind) == domain.ChannelKindEmail {
+		rule = "email"
+	}
+	if request.Target != "" && sl.Validator().Var(request.Target, rule) != nil {
+		sl.ReportError(request.Target, "target", "Target", rule, "")
+	}
+}
@@ -0,0 +1,95 @@
+package infrastructure
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type NotificationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) domain.NotificationRepository {
+	return &NotificationRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *NotificationRepositoryImpl) CreateChannel(channel *domain.NotificationChannel, ctx context.Context) error {
+	return r.db.WithContext(ctx).Create(channel).Error
+}
+
+func (r *NotificationRepositoryImpl) UpdateChannel(channel *domain.NotificationChannel, ctx context.Context) error {
+	return r.db.WithContext(ctx).Save(channel).Error
+}
+
+func (r *NotificationRepositoryImpl) GetChannelById(id string, ctx context.Context) (*domain.NotificationChannel, error) {
+	var channel domain.NotificationChannel
+	err := r.db.WithContext(ctx).First(&channel, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &channel, nil
+}
+
+func (r *NotificationRepositoryImpl) GetChannelsByUser(userId string, ctx context.Context) ([]*domain.NotificationChannel, error) {
+	var channels []*domain.NotificationChannel
+	err := r.db.WithContext(ctx).Where("user_id = ?", userId).Find(&channels).Error
+	if err != nil {
+		return nil, err
+	}
+	return channels, nil
+}
+
+func (r *NotificationRepositoryImpl) GetEnabledChannels(userId string, ctx context.Context) ([]*domain.NotificationChannel, error) {
+	var channels []*domain.NotificationChannel
+	err := r.db.WithContext(ctx).Where("user_id = ? AND enabled = ?", userId, true).Find(&channels).Error
+	if err != nil {
+		return nil, err
+	}
+	return channels, nil
+}
+
+func (r *NotificationRepositoryImpl) DeleteChannel(id string, ctx context.Context) error {
+	result := r.db.WithContext(ctx).Delete(&domain.NotificationChannel{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrChannelNotFound
+	}
+	return nil
+}
+
+func (r *NotificationRepositoryImpl) CreateDelivery(delivery *domain.NotificationDelivery, ctx context.Context) error {
+	return r.db.WithContext(ctx).Create(delivery).Error
+}
+
+func (r *NotificationRepositoryImpl) UpdateDelivery(delivery *domain.NotificationDelivery, ctx context.Context) error {
+	return r.db.WithContext(ctx).Save(delivery).Error
+}
+
+func (r *NotificationRepositoryImpl) GetDeliveriesByChannel(channelId string, ctx context.Context) ([]*domain.NotificationDelivery, error) {
+	var deliveries []*domain.NotificationDelivery
+	err := r.db.WithContext(ctx).Where("channel_id = ?", channelId).Order("created_at desc").Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (r *NotificationRepositoryImpl) GetDueDeliveries(now time.Time, limit int, ctx context.Context) ([]*domain.NotificationDelivery, error) {
+	var deliveries []*domain.NotificationDelivery
+	err := r.db.WithContext(ctx).
+		Where("status IN ? AND next_attempt_at <= ?", []domain.DeliveryStatus{domain.DeliveryStatusPending, domain.DeliveryStatusRetrying}, now).
+		Order("created_at").
+		Limit(limit).
+		Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
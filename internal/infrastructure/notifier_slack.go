@@ -0,0 +1,41 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"job-tracker/internal/domain"
+	"net/http"
+	"time"
+)
+
+type SlackSender struct {
+	client *http.Client
+}
+
+func NewSlackSender() *SlackSender {
+	return &SlackSender{client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (s *SlackSender) Kind() domain.ChannelKind {
+	return domain.ChannelKindSlack
+}
+
+// Send posts an incoming-webhook message. Slack reads "text" and Discord reads
+// "content", so both are set.
+func (s *SlackSender) Send(ctx context.Context, channel *domain.NotificationChannel, notification domain.Notification) error {
+	text := fmt.Sprintf("*%s*\n%s", notification.Subject, notification.Message)
+	body, err := json.Marshal(map[string]string{"text": text, "content": text})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, channel.Target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return doWebhookRequest(s.client, req)
+}
@@ -0,0 +1,86 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"job-tracker/internal/domain"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+type SMTPSender struct {
+	config SMTPConfig
+}
+
+func NewSMTPSender(config SMTPConfig) *SMTPSender {
+	return &SMTPSender{config: config}
+}
+
+func (s *SMTPSender) Kind() domain.ChannelKind {
+	return domain.ChannelKindEmail
+}
+
+func (s *SMTPSender) Send(ctx context.Context, channel *domain.NotificationChannel, notification domain.Notification) error {
+	if s.config.Host == "" {
+		return fmt.Errorf("smtp host not configured")
+	}
+
+	// Targets are validated on creation; this guards channels stored before
+	// that, since a line break would let the address add its own headers.
+	if strings.ContainsAny(channel.Target, "\r\n") {
+		return fmt.Errorf("invalid email target %q", channel.Target)
+	}
+
+	addr := net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
+
+	var auth smtp.Auth
+	if s.config.Username != "" {
+		auth = smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- smtp.SendMail(addr, auth, s.config.From, []string{channel.Target}, s.message(channel.Target, notification))
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// message builds the email. The subject comes from job fields that users or
+// scraped pages control, so line breaks are dropped and non-ASCII text is
+// Q-encoded rather than written into the header raw.
+func (s *SMTPSender) message(to string, notification domain.Notification) []byte {
+	var msg strings.Builder
+	msg.WriteString("From: " + headerValue(s.config.From) + "\r\n")
+	msg.WriteString("To: " + headerValue(to) + "\r\n")
+	msg.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", headerValue(notification.Subject)) + "\r\n")
+	msg.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(notification.Message + "\r\n")
+	return []byte(msg.String())
+}
+
+// headerValue folds CR and LF into spaces so a value cannot end its header
+// line and start another one.
+func headerValue(value string) string {
+	return strings.Join(strings.FieldsFunc(value, func(r rune) bool { return r == '\r' || r == '\n' }), " ")
+}
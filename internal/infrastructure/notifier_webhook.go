@@ -0,0 +1,98 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"job-tracker/internal/domain"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Job-Tracker-Signature"
+	TimestampHeader = "X-Job-Tracker-Timestamp"
+	EventHeader     = "X-Job-Tracker-Event"
+)
+
+type WebhookSender struct {
+	client *http.Client
+}
+
+func NewWebhookSender() *WebhookSender {
+	return &WebhookSender{client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (s *WebhookSender) Kind() domain.ChannelKind {
+	return domain.ChannelKindWebhook
+}
+
+func (s *WebhookSender) Send(ctx context.Context, channel *domain.NotificationChannel, notification domain.Notification) error {
+	body, err := json.Marshal(map[string]string{
+		"event":   string(notification.Event),
+		"subject": notification.Subject,
+		"message": notification.Message,
+		"jobId":   notification.JobId.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := newSignedRequest(ctx, channel.Target, channel.Secret, string(notification.Event), body)
+	if err != nil {
+		return err
+	}
+	return doWebhookRequest(s.client, req)
+}
+
+// SignPayload returns the hex HMAC-SHA256 of "<timestamp>.<body>" keyed by secret.
+func SignPayload(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newSignedRequest(ctx context.Context, url string, secret string, event string, body []byte) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, event)
+	req.Header.Set(TimestampHeader, timestamp)
+	if secret != "" {
+		req.Header.Set(SignatureHeader, "sha256="+SignPayload(secret, timestamp, body))
+	}
+	return req, nil
+}
+
+func doWebhookRequest(client *http.Client, req *http.Request) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func NewNotificationSenders(config SMTPConfig) []domain.NotificationSender {
+	return []domain.NotificationSender{
+		NewSMTPSender(config),
+		NewSlackSender(),
+		NewWebhookSender(),
+	}
+}
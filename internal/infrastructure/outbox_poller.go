@@ -0,0 +1,40 @@
+package infrastructure
+
+import (
+	"context"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"time"
+)
+
+type OutboxPoller struct {
+	relay    *application.EventRelay
+	log      domain.Logger
+	interval time.Duration
+}
+
+func NewOutboxPoller(relay *application.EventRelay, log domain.Logger) *OutboxPoller {
+	return &OutboxPoller{
+		relay:    relay,
+		log:      log,
+		interval: time.Second,
+	}
+}
+
+func (p *OutboxPoller) InitPoll(ctx context.Context) error {
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := p.relay.RelayPending(ctx); err != nil {
+				p.log.Error(ctx, "error relaying outbox events", err)
+			}
+		case <-ctx.Done():
+			p.log.Info(ctx, "outbox poller stopped")
+			return nil
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"job-tracker/internal/domain"
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OutboxRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) domain.OutboxRepository {
+	return &OutboxRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *OutboxRepositoryImpl) GetEventsAfter(sequence int64, limit int, ctx context.Context) ([]domain.Event, error) {
+	var messages []domain.OutboxMessage
+	err := r.db.WithContext(ctx).Where("sequence > ?", sequence).Order("sequence").Limit(limit).Find(&messages).Error
+	if err != nil {
+		return nil, err
+	}
+	return decodeMessages(messages)
+}
+
+// GetEventsForJob returns the latest events of a job, oldest first.
+func (r *OutboxRepositoryImpl) GetEventsForJob(jobId string, limit int, ctx context.Context) ([]domain.Event, error) {
+	var messages []domain.OutboxMessage
+	err := r.db.WithContext(ctx).Where("job_id = ?", jobId).Order("sequence DESC").Limit(limit).Find(&messages).Error
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(messages)
+	return decodeMessages(messages)
+}
+
+func decodeMessages(messages []domain.OutboxMessage) ([]domain.Event, error) {
+	events := make([]domain.Event, 0, len(messages))
+	for _, message := range messages {
+		var event domain.Event
+		if err := json.Unmarshal([]byte(message.Payload), &event); err != nil {
+			return nil, err
+		}
+		event.Sequence = message.Sequence
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (r *OutboxRepositoryImpl) GetLatestSequence(ctx context.Context) (int64, error) {
+	var sequence int64
+	err := r.db.WithContext(ctx).Model(&domain.OutboxMessage{}).Select("COALESCE(MAX(sequence), 0)").Scan(&sequence).Error
+	if err != nil {
+		return 0, err
+	}
+	return sequence, nil
+}
+
+func (r *OutboxRepositoryImpl) GetCheckpoint(subscriber string, ctx context.Context) (int64, error) {
+	var checkpoint domain.OutboxCheckpoint
+	err := r.db.WithContext(ctx).Where("subscriber = ?", subscriber).Limit(1).Find(&checkpoint).Error
+	if err != nil {
+		return 0, err
+	}
+	return checkpoint.Sequence, nil
+}
+
+func (r *OutboxRepositoryImpl) SaveCheckpoint(subscriber string, sequence int64, ctx context.Context) error {
+	checkpoint := domain.OutboxCheckpoint{Subscriber: subscriber, Sequence: sequence, UpdatedAt: time.Now()}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "subscriber"}},
+		DoUpdates: clause.AssignmentColumns([]string{"sequence", "updated_at"}),
+	}).Create(&checkpoint).Error
+}
+
+// appendToOutbox writes events with the transaction that produced them.
+func appendToOutbox(tx *gorm.DB, events ...domain.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	messages := make([]domain.OutboxMessage, 0, len(events))
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, domain.OutboxMessage{
+			EventId:    event.Id,
+			Type:       event.Type,
+			JobId:      event.JobId,
+			Payload:    string(payload),
+			OccurredAt: event.OccurredAt,
+		})
+	}
+	return tx.Create(&messages).Error
+}
@@ -0,0 +1,93 @@
+package infrastructure
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// Posting is what can be read from a job posting page without knowing the
+// site: Open Graph tags first, then the document title and description.
+type Posting struct {
+	Company     string
+	Position    string
+	Description string
+}
+
+type PostingReader struct {
+	client *http.Client
+}
+
+func NewPostingReader() *PostingReader {
+	return &PostingReader{client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (r *PostingReader) Read(rawURL string, ctx context.Context) (Posting, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return Posting{}, fmt.Errorf("invalid posting URL %q", rawURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return Posting{}, err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return Posting{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Posting{}, fmt.Errorf("unexpected HTTP status %d", resp.StatusCode)
+	}
+
+	doc, err := html.Parse(io.LimitReader(resp.Body, 5<<20))
+	if err != nil {
+		return Posting{}, err
+	}
+
+	meta := map[string]string{}
+	var title string
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			switch n.Data {
+			case "title":
+				if title == "" && n.FirstChild != nil {
+					title = n.FirstChild.Data
+				}
+			case "meta":
+				var key, content string
+				for _, attr := range n.Attr {
+					switch attr.Key {
+					case "property", "name":
+						key = strings.ToLower(attr.Val)
+					case "content":
+						content = attr.Val
+					}
+				}
+				if key != "" {
+					meta[key] = strings.TrimSpace(content)
+				}
+			}
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(doc)
+
+	return Posting{
+		Company:     cmp.Or(meta["og:site_name"], strings.TrimPrefix(parsed.Hostname(), "www.")),
+		Position:    cmp.Or(meta["og:title"], strings.TrimSpace(title)),
+		Description: cmp.Or(meta["og:description"], meta["description"], rawURL),
+	}, nil
+}
@@ -0,0 +1,168 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const problemContentType = "application/problem+json"
+
+// Problem types are relative URI references, resolved against the API root.
+const (
+	problemTypeNotFound             = "/problems/not-found"
+	problemTypeVersionConflict      = "/problems/version-conflict"
+	problemTypeBulkAborted          = "/problems/bulk-aborted"
+	problemTypeInvalidRequest       = "/problems/invalid-request"
+	problemTypeValidation           = "/problems/validation-error"
+	problemTypeUnsupportedMediaType = "/problems/unsupported-media-type"
+	problemTypeInternal             = "/problems/internal-error"
+)
+
+func init() {
+	// Report violations by their JSON names rather than Go field names.
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(jsonFieldName)
+		v.RegisterStructValidation(validateChannelTarget, application.CreateChannelRequest{})
+	}
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return field.Name
+	default:
+		return name
+	}
+}
+
+func newProblem(status int, problemType string, detail string) domain.Problem {
+	return domain.Problem{
+		Type:   problemType,
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+	}
+}
+
+// problemFor maps an error returned by a service to its problem. Errors that
+// are not part of the domain are reported as internal without details.
+func problemFor(err error) domain.Problem {
+	switch {
+	case errors.Is(err, domain.ErrJobNotFound),
+		errors.Is(err, domain.ErrChannelNotFound),
+		errors.Is(err, domain.ErrWebhookNotFound),
+		errors.Is(err, domain.ErrDeliveryNotFound):
+		return newProblem(http.StatusNotFound, problemTypeNotFound, err.Error())
+	case errors.Is(err, domain.ErrVersionConflict):
+		return newProblem(http.StatusPreconditionFailed, problemTypeVersionConflict, domain.ErrVersionConflict.Error())
+	case errors.Is(err, domain.ErrBulkAborted):
+		return newProblem(http.StatusFailedDependency, problemTypeBulkAborted, domain.ErrBulkAborted.Error())
+	case errors.Is(err, domain.ErrInvalidRequest):
+		return newProblem(http.StatusBadRequest, problemTypeInvalidRequest, err.Error())
+	default:
+		return newProblem(http.StatusInternalServerError, problemTypeInternal, "")
+	}
+}
+
+func writeProblem(c *gin.Context, problem domain.Problem) {
+	problem.Instance = c.Request.URL.Path
+	if span := trace.SpanContextFromContext(c.Request.Context()); span.HasTraceID() {
+		problem.TraceId = span.TraceID().String()
+	}
+	c.Header("Content-Type", problemContentType)
+	c.AbortWithStatusJSON(problem.Status, problem)
+}
+
+func invalidRequest(c *gin.Context, detail string) {
+	writeProblem(c, newProblem(http.StatusBadRequest, problemTypeInvalidRequest, detail))
+}
+
+// bindError reports why a request body could not be bound, listing each field
+// that failed validation.
+func bindError(c *gin.Context, err error) {
+	var validationErrors validator.ValidationErrors
+	var typeError *json.UnmarshalTypeError
+	var syntaxError *json.SyntaxError
+	switch {
+	case errors.As(err, &validationErrors):
+		problem := newProblem(http.StatusBadRequest, problemTypeValidation, "the request body has invalid fields")
+		for _, fieldError := range validationErrors {
+			problem.Violations = append(problem.Violations, domain.Violation{
+				Field:   violationField(fieldError),
+				Rule:    fieldError.Tag(),
+				Message: violationMessage(fieldError),
+			})
+		}
+		writeProblem(c, problem)
+	case errors.As(err, &typeError):
+		problem := newProblem(http.StatusBadRequest, problemTypeValidation, "the request body has invalid fields")
+		problem.Violations = []domain.Violation{{
+			Field:   typeError.Field,
+			Rule:    "type",
+			Message: "must be a " + typeError.Type.String(),
+		}}
+		writeProblem(c, problem)
+	case errors.As(err, &syntaxError):
+		invalidRequest(c, fmt.Sprintf("malformed JSON at offset %d", syntaxError.Offset))
+	default:
+		invalidRequest(c, domain.ErrInvalidRequest.Error())
+	}
+}
+
+// violationField drops the request struct name from the namespace, so
+// "BulkJobsRequest.operations[0].op" becomes "operations[0].op".
+func violationField(fieldError validator.FieldError) string {
+	_, field, found := strings.Cut(fieldError.Namespace(), ".")
+	if !found {
+		return fieldError.Field()
+	}
+	return field
+}
+
+func violationMessage(fieldError validator.FieldError) string {
+	switch fieldError.Tag() {
+	case "required":
+		return "is required"
+	case "min":
+		return boundMessage("at least", fieldError)
+	case "max":
+		return boundMessage("at most", fieldError)
+	case "oneof":
+		return "must be one of: " + strings.ReplaceAll(fieldError.Param(), " ", ", ")
+	case "url":
+		return "must be a valid URL"
+	case "http_url":
+		return "must be a valid http or https URL"
+	case "email":
+		return "must be a valid email address"
+	case "uuid", "uuid4":
+		return "must be a valid UUID"
+	default:
+		return "failed the " + fieldError.Tag() + " rule"
+	}
+}
+
+func boundMessage(bound string, fieldError validator.FieldError) string {
+	switch fieldError.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return "must have " + bound + " " + fieldError.Param() + " items"
+	case reflect.String:
+		return "must have " + bound + " " + fieldError.Param() + " characters"
+	default:
+		return "must be " + bound + " " + fieldError.Param()
+	}
+}
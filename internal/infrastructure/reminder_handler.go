@@ -0,0 +1,54 @@
+package infrastructure
+
+import (
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReminderHandler struct {
+	service *application.ReminderService
+	logger  domain.Logger
+}
+
+func NewReminderHandler(s *application.ReminderService, logger domain.Logger) *ReminderHandler {
+	return &ReminderHandler{service: s, logger: logger}
+}
+
+func (h *ReminderHandler) RegisterRoutes(r gin.IRouter) {
+	r.GET("/reminders", h.GetReminders)
+	r.POST("/reminders", h.CreateReminder)
+}
+
+func (h *ReminderHandler) GetReminders(c *gin.Context) {
+	h.logger.Info(c.Request.Context(), "getting reminders")
+	reminders, err := h.service.GetReminders(c.Query("due"), c.Request.Context())
+	isError := hasError(err, c)
+	if isError {
+		h.logger.Error(c.Request.Context(), "failed to get reminders", err)
+		return
+	}
+	c.JSON(http.StatusOK, reminders)
+}
+
+func (h *ReminderHandler) CreateReminder(c *gin.Context) {
+	h.logger.Info(c.Request.Context(), "creating reminder")
+	var request application.CreateReminderRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(c.Request.Context(), "invalid request body", err)
+		bindError(c, err)
+		return
+	}
+
+	reminder, err := h.service.CreateReminder(&request, c.Request.Context())
+	isError := hasError(err, c)
+	if isError {
+		h.logger.Error(c.Request.Context(), "failed to create reminder", err)
+		return
+	}
+	h.logger.Info(c.Request.Context(), "reminder created successfully")
+	c.JSON(http.StatusCreated, reminder)
+}
@@ -0,0 +1,78 @@
+package infrastructure
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReminderRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewReminderRepository(db *gorm.DB) domain.ReminderRepository {
+	return &ReminderRepositoryImpl{
+		db: db,
+	}
+}
+
+// CreateReminder skips a reminder already scheduled for the same job and
+// event.
+func (r *ReminderRepositoryImpl) CreateReminder(reminder *domain.Reminder, ctx context.Context) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "job_id"}, {Name: "event_id"}},
+		DoNothing: true,
+	}).Create(reminder).Error
+}
+
+func (r *ReminderRepositoryImpl) UpdateReminder(reminder *domain.Reminder, ctx context.Context) error {
+	return r.db.WithContext(ctx).Save(reminder).Error
+}
+
+// GetPending leaves out reminders of jobs in the trash, as GetDueBefore does.
+func (r *ReminderRepositoryImpl) GetPending(ctx context.Context) ([]*domain.Reminder, error) {
+	var reminders []*domain.Reminder
+	err := r.db.WithContext(ctx).Select("reminders.*").
+		Joins("JOIN jobs ON jobs.id = reminders.job_id AND jobs.deleted_at IS NULL").
+		Where("reminders.done = ?", false).
+		Order("reminders.due_at").
+		Find(&reminders).Error
+	if err != nil {
+		return nil, err
+	}
+	return reminders, nil
+}
+
+// GetDueBefore leaves out reminders of jobs in the trash, so they wait until
+// the job is restored instead of being picked up on every run.
+func (r *ReminderRepositoryImpl) GetDueBefore(before time.Time, ctx context.Context) ([]*domain.Reminder, error) {
+	var reminders []*domain.Reminder
+	err := r.db.WithContext(ctx).Select("reminders.*").
+		Joins("JOIN jobs ON jobs.id = reminders.job_id AND jobs.deleted_at IS NULL").
+		Where("reminders.done = ? AND reminders.due_at <= ?", false, before).
+		Order("reminders.due_at").
+		Find(&reminders).Error
+	if err != nil {
+		return nil, err
+	}
+	return reminders, nil
+}
+
+func (r *ReminderRepositoryImpl) GetByJobIds(jobIds []string, ctx context.Context) ([]*domain.Reminder, error) {
+	var reminders []*domain.Reminder
+	err := r.db.WithContext(ctx).Where("job_id IN ?", jobIds).Order("due_at").Find(&reminders).Error
+	if err != nil {
+		return nil, err
+	}
+	return reminders, nil
+}
+
+func (r *ReminderRepositoryImpl) CompleteRuleReminders(jobId string, keep uuid.UUID, ctx context.Context) error {
+	return r.db.WithContext(ctx).Model(&domain.Reminder{}).
+		Where("job_id = ? AND done = ? AND event_id IS NOT NULL AND event_id <> ?", jobId, false, keep).
+		Updates(map[string]any{"done": true, "updated_at": time.Now()}).Error
+}
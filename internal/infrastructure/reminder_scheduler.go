@@ -0,0 +1,40 @@
+package infrastructure
+
+import (
+	"context"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"time"
+)
+
+type ReminderScheduler struct {
+	service  *application.ReminderService
+	log      domain.Logger
+	interval time.Duration
+}
+
+func NewReminderScheduler(service *application.ReminderService, log domain.Logger) *ReminderScheduler {
+	return &ReminderScheduler{
+		service:  service,
+		log:      log,
+		interval: time.Minute,
+	}
+}
+
+func (s *ReminderScheduler) InitSchedule(ctx context.Context) error {
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			if err := s.service.FireDue(now, ctx); err != nil {
+				s.log.Error(ctx, "error firing reminders", err)
+			}
+		case <-ctx.Done():
+			s.log.Info(ctx, "reminder scheduler stopped")
+			return nil
+		}
+	}
+}
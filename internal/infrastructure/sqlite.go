@@ -0,0 +1,22 @@
+package infrastructure
+
+import (
+	"net/url"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+// sqlitePragmas are applied to every connection in the pool. WAL lets readers
+// run next to the single writer, the busy timeout makes a writer wait for the
+// lock instead of failing, and immediate transactions take that lock up front
+// so a read-then-write transaction cannot deadlock against another one.
+var sqlitePragmas = url.Values{
+	"_pragma": {"journal_mode(WAL)", "busy_timeout(5000)", "foreign_keys(1)"},
+	"_txlock": {"immediate"},
+}
+
+// OpenSQLite opens the database file at path, creating it when missing.
+func OpenSQLite(path string, config *gorm.Config) (*gorm.DB, error) {
+	return gorm.Open(sqlite.Open("file:"+path+"?"+sqlitePragmas.Encode()), config)
+}
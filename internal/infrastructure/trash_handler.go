@@ -0,0 +1,51 @@
+package infrastructure
+
+import (
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TrashHandler struct {
+	service *application.TrashService
+	logger  domain.Logger
+}
+
+func NewTrashHandler(s *application.TrashService, logger domain.Logger) *TrashHandler {
+	return &TrashHandler{service: s, logger: logger}
+}
+
+func (h *TrashHandler) RegisterRoutes(r gin.IRouter) {
+	r.GET("/trash", h.GetTrash)
+	r.POST("/jobs/:id/restore", h.RestoreJob)
+}
+
+func (h *TrashHandler) GetTrash(c *gin.Context) {
+	h.logger.Info(c.Request.Context(), "getting deleted jobs")
+	jobs, err := h.service.GetTrash(c.Request.Context())
+	isError := hasError(err, c)
+	if isError {
+		h.logger.Error(c.Request.Context(), "failed to get deleted jobs", err)
+		return
+	}
+	c.JSON(http.StatusOK, jobs)
+}
+
+func (h *TrashHandler) RestoreJob(c *gin.Context) {
+	h.logger.Info(c.Request.Context(), "restoring job")
+	id, ok := parseUUID(c, c.Param("id"))
+	if !ok {
+		return
+	}
+	job, err := h.service.RestoreJob(id, c.Request.Context())
+	isError := hasError(err, c)
+	if isError {
+		h.logger.Error(c.Request.Context(), "failed to restore job", err)
+		return
+	}
+	h.logger.Info(c.Request.Context(), "job restored successfully")
+	setETag(c, job)
+	c.JSON(http.StatusOK, job)
+}
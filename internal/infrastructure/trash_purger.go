@@ -0,0 +1,44 @@
+package infrastructure
+
+import (
+	"context"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"time"
+)
+
+type TrashPurger struct {
+	service  *application.TrashService
+	log      domain.Logger
+	interval time.Duration
+}
+
+func NewTrashPurger(service *application.TrashService, log domain.Logger) *TrashPurger {
+	return &TrashPurger{
+		service:  service,
+		log:      log,
+		interval: time.Hour,
+	}
+}
+
+func (p *TrashPurger) InitPurge(ctx context.Context) error {
+
+	if err := p.service.Purge(time.Now(), ctx); err != nil {
+		p.log.Error(ctx, "error purging trash", err)
+	}
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			if err := p.service.Purge(now, ctx); err != nil {
+				p.log.Error(ctx, "error purging trash", err)
+			}
+		case <-ctx.Done():
+			p.log.Info(ctx, "trash purger stopped")
+			return nil
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Partial page updates with a small subset of htmx attributes (hx-get,
+// hx-post, hx-target, hx-swap and hx-trigger="change"), so the UI needs no
+// build step and htmx itself could be dropped in later.
+(() => {
+  "use strict";
+
+  const eventTypes = [
+    "job.created", "job.updated", "job.status_changed", "job.deleted",
+    "job.restored", "job.archived", "job.unarchived",
+  ];
+
+  function targetOf(el) {
+    const holder = el.closest("[hx-target]");
+    const selector = holder && holder.getAttribute("hx-target");
+    return selector ? document.querySelector(selector) : el;
+  }
+
+  function swap(target, html, mode) {
+    if (!target) return;
+    if (mode === "innerHTML") {
+      target.innerHTML = html;
+    } else {
+      target.outerHTML = html;
+    }
+  }
+
+  async function request(method, url, body, target, mode) {
+    const response = await fetch(url, {
+      method,
+      body,
+      headers: { "HX-Request": "true" },
+    });
+    const redirect = response.headers.get("HX-Redirect");
+    if (redirect) {
+      window.location.assign(redirect);
+      return;
+    }
+    swap(target, await response.text(), mode);
+  }
+
+  document.addEventListener("submit", (event) => {
+    const form = event.target.closest("form[hx-post]");
+    if (!form) return;
+    event.preventDefault();
+    request("POST", form.getAttribute("hx-post"), new FormData(form), targetOf(form), form.getAttribute("hx-swap"));
+  });
+
+  document.addEventListener("change", (event) => {
+    if (event.target.getAttribute("hx-trigger") !== "change") return;
+    const form = event.target.closest("form");
+    if (form) form.requestSubmit();
+  });
+
+  document.addEventListener("click", (event) => {
+    const el = event.target.closest("[hx-get]");
+    if (!el) return;
+    event.preventDefault();
+    request("GET", el.getAttribute("hx-get"), undefined, targetOf(el), el.getAttribute("hx-swap"));
+  });
+
+  // Kanban drag and drop: dropping a card on a column changes its status.
+  document.addEventListener("dragstart", (event) => {
+    const card = event.target.closest(".card");
+    if (!card) return;
+    event.dataTransfer.setData("text/plain", JSON.stringify({ id: card.dataset.id, version: card.dataset.version }));
+    event.dataTransfer.effectAllowed = "move";
+  });
+
+  document.addEventListener("dragover", (event) => {
+    if (event.target.closest(".column")) event.preventDefault();
+  });
+
+  document.addEventListener("drop", (event) => {
+    const column = event.target.closest(".column");
+    if (!column) return;
+    event.preventDefault();
+    const card = JSON.parse(event.dataTransfer.getData("text/plain"));
+    const body = new FormData();
+    body.set("status", column.dataset.status);
+    body.set("version", card.version);
+    request("POST", `/ui/jobs/${card.id}/status?view=board`, body, document.getElementById("board"));
+  });
+
+  // Live refresh: re-render the partial when a job event arrives. Bursts of
+  // events are coalesced into one request.
+  for (const el of document.querySelectorAll("[data-live]")) {
+    const source = new EventSource(el.dataset.live);
+    let pending;
+    const refresh = () => {
+      clearTimeout(pending);
+      pending = setTimeout(() => {
+        request("GET", el.dataset.refresh, undefined, targetOf(el.firstElementChild || el));
+      }, 300);
+    };
+    for (const type of eventTypes) source.addEventListener(type, refresh);
+  }
+})();
@@ -0,0 +1,366 @@
+package infrastructure
+
+import (
+	"bytes"
+	"cmp"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+)
+
+//go:embed web
+var webFS embed.FS
+
+const timelineLength = 50
+
+// fragmentKey marks routes that always answer with a fragment.
+const fragmentKey = "web.fragment"
+
+// WebHandler serves the server-rendered UI under /ui. Pages are full HTML
+// documents; requests made by app.js carry HX-Request and only get the
+// fragment that changed.
+type WebHandler struct {
+	jobs     *application.JobService
+	stream   *application.EventStream
+	postings *PostingReader
+	logger   domain.Logger
+	pages    map[string]*template.Template
+}
+
+type boardColumn struct {
+	Status domain.JobStatus
+	Jobs   []*domain.Job
+}
+
+type timelineEntry struct {
+	At   time.Time
+	Text string
+}
+
+type detailView struct {
+	Title    string
+	Job      *domain.Job
+	Statuses []domain.JobStatus
+	Timeline []timelineEntry
+	Error    string
+}
+
+type newJobView struct {
+	Title string
+	Form  application.CreateJobRequest
+	Error string
+}
+
+type statsView struct {
+	Title           string
+	Stats           *application.JobStats
+	MaxWeekly       int
+	IncludeArchived bool
+}
+
+func NewWebHandler(jobs *application.JobService, stream *application.EventStream, postings *PostingReader, logger domain.Logger) *WebHandler {
+	funcs := template.FuncMap{
+		"inStatus": inStatus,
+		"percent":  percent,
+	}
+	pages := make(map[string]*template.Template)
+	for _, page := range []string{"board", "detail", "new", "stats"} {
+		pages[page] = template.Must(template.New("").Funcs(funcs).ParseFS(webFS,
+			"web/templates/layout.html",
+			"web/templates/"+page+".html",
+		))
+	}
+	return &WebHandler{jobs: jobs, stream: stream, postings: postings, logger: logger, pages: pages}
+}
+
+func (h *WebHandler) RegisterRoutes(r gin.IRouter) {
+	static, err := fs.Sub(webFS, "web/static")
+	if err != nil {
+		panic(err)
+	}
+	r.GET("/", func(c *gin.Context) { c.Redirect(http.StatusFound, "/ui") })
+	r.GET("/ui", h.GetBoard)
+	r.GET("/ui/board", fragment, h.GetBoard)
+	r.GET("/ui/new", h.GetNewJob)
+	r.POST("/ui/jobs", h.CreateJob)
+	r.GET("/ui/jobs/:id", h.GetJob)
+	r.GET("/ui/jobs/:id/detail", fragment, h.GetJob)
+	r.POST("/ui/jobs/:id/status", h.UpdateJobStatus)
+	r.POST("/ui/jobs/:id/notes", h.UpdateNotes)
+	r.GET("/ui/stats", h.GetStats)
+	r.StaticFS("/ui/static", http.FS(static))
+}
+
+func (h *WebHandler) GetBoard(c *gin.Context) {
+	columns, err := h.board(c)
+	if err != nil {
+		h.fail(c, "failed to render board", err)
+		return
+	}
+	h.render(c, http.StatusOK, "board", "board", gin.H{"Title": "Board", "Columns": columns})
+}
+
+func (h *WebHandler) GetJob(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"))
+	if !ok {
+		return
+	}
+	view, err := h.detail(id, c)
+	if err != nil {
+		h.fail(c, "failed to render job", err)
+		return
+	}
+	h.render(c, http.StatusOK, "detail", "detail", view)
+}
+
+func (h *WebHandler) UpdateJobStatus(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"))
+	if !ok {
+		return
+	}
+	version, _ := strconv.Atoi(c.PostForm("version"))
+	request := &application.UpdateJobStatusRequest{Status: c.PostForm("status"), Version: version}
+	_, updateErr := h.jobs.UpdateJobStatus(id, request, c.Request.Context())
+	if updateErr != nil && !errors.Is(updateErr, domain.ErrVersionConflict) {
+		h.fail(c, "failed to update job status", updateErr)
+		return
+	}
+
+	if c.Query("view") == "board" {
+		h.GetBoard(c)
+		return
+	}
+	view, err := h.detail(id, c)
+	if err != nil {
+		h.fail(c, "failed to render job", err)
+		return
+	}
+	status := http.StatusOK
+	if updateErr != nil {
+		status = http.StatusConflict
+		view.Error = "This job was changed somewhere else; the latest version is shown."
+	}
+	h.render(c, status, "detail", "detail", view)
+}
+
+func (h *WebHandler) UpdateNotes(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"))
+	if !ok {
+		return
+	}
+	version, _ := strconv.Atoi(c.PostForm("version"))
+	patch, err := json.Marshal(map[string]string{domain.JobFieldNotes: c.PostForm("notes")})
+	if err != nil {
+		h.fail(c, "failed to encode notes", err)
+		return
+	}
+	request := &application.PatchJobRequest{Format: application.PatchFormatMerge, Patch: patch, Version: version}
+	_, patchErr := h.jobs.PatchJob(id, request, c.Request.Context())
+	if patchErr != nil && !errors.Is(patchErr, domain.ErrVersionConflict) {
+		h.fail(c, "failed to save notes", patchErr)
+		return
+	}
+
+	view, err := h.detail(id, c)
+	if err != nil {
+		h.fail(c, "failed to render job", err)
+		return
+	}
+	status := http.StatusOK
+	if patchErr != nil {
+		status = http.StatusConflict
+		view.Error = "This job was changed somewhere else; your notes were not saved."
+	}
+	h.render(c, status, "detail", "detail", view)
+}
+
+func (h *WebHandler) GetNewJob(c *gin.Context) {
+	h.render(c, http.StatusOK, "new", "layout", newJobView{Title: "Add a job"})
+}
+
+// CreateJob reads the posting at the submitted URL and creates a job from it.
+// Fields filled in on the form take precedence over what was read.
+func (h *WebHandler) CreateJob(c *gin.Context) {
+	h.logger.Info(c.Request.Context(), "creating job from posting")
+	view := newJobView{Title: "Add a job"}
+	view.Form = application.CreateJobRequest{
+		Url:      strings.TrimSpace(c.PostForm("url")),
+		Company:  strings.TrimSpace(c.PostForm("company")),
+		Position: strings.TrimSpace(c.PostForm("position")),
+		Remote:   c.PostForm("remote") != "",
+	}
+	if salary := c.PostForm("salary"); salary != "" {
+		parsed, err := strconv.Atoi(salary)
+		if err != nil || parsed < 0 {
+			view.Error = "Salary must be a positive number."
+			h.render(c, http.StatusUnprocessableEntity, "new", "layout", view)
+			return
+		}
+		view.Form.Salary = parsed
+	}
+
+	posting, err := h.postings.Read(view.Form.Url, c.Request.Context())
+	if err != nil {
+		h.logger.Error(c.Request.Context(), "failed to read posting", err)
+		view.Error = fmt.Sprintf("Could not read the posting: %v", err)
+		h.render(c, http.StatusUnprocessableEntity, "new", "layout", view)
+		return
+	}
+	request := view.Form
+	request.Company = cmp.Or(request.Company, posting.Company)
+	request.Position = cmp.Or(request.Position, posting.Position)
+	request.Description = posting.Description
+	if err := binding.Validator.ValidateStruct(&request); err != nil {
+		view.Form = request
+		view.Error = "The company, position and description could not all be read from the posting; fill in the missing fields."
+		h.render(c, http.StatusUnprocessableEntity, "new", "layout", view)
+		return
+	}
+
+	job, err := h.jobs.CreateJob(&request, c.Request.Context())
+	if err != nil {
+		h.fail(c, "failed to create job", err)
+		return
+	}
+	location := "/ui/jobs/" + job.Id.String()
+	if c.GetHeader("HX-Request") != "" {
+		c.Header("HX-Redirect", location)
+		c.Status(http.StatusCreated)
+		return
+	}
+	c.Redirect(http.StatusSeeOther, location)
+}
+
+func (h *WebHandler) GetStats(c *gin.Context) {
+	stats, err := h.jobs.Stats(includeArchived(c), time.Now(), c.Request.Context())
+	if err != nil {
+		h.fail(c, "failed to compute stats", err)
+		return
+	}
+	view := statsView{Title: "Stats", Stats: stats, IncludeArchived: includeArchived(c)}
+	for _, week := range stats.Weekly {
+		view.MaxWeekly = max(view.MaxWeekly, week.Count)
+	}
+	h.render(c, http.StatusOK, "stats", "layout", view)
+}
+
+func (h *WebHandler) board(c *gin.Context) ([]boardColumn, error) {
+	jobs, err := h.jobs.GetAllJobs(false, c.Request.Context())
+	if err != nil {
+		return nil, err
+	}
+	columns := make([]boardColumn, len(domain.JobStatuses))
+	index := make(map[domain.JobStatus]int, len(domain.JobStatuses))
+	for i, status := range domain.JobStatuses {
+		columns[i].Status = status
+		index[status] = i
+	}
+	for _, job := range jobs {
+		if i, ok := index[job.Status]; ok {
+			columns[i].Jobs = append(columns[i].Jobs, job)
+		}
+	}
+	return columns, nil
+}
+
+func (h *WebHandler) detail(id uuid.UUID, c *gin.Context) (detailView, error) {
+	job, err := h.jobs.GetJob(id, c.Request.Context())
+	if err != nil {
+		return detailView{}, err
+	}
+	events, err := h.stream.History(id, timelineLength, c.Request.Context())
+	if err != nil {
+		return detailView{}, err
+	}
+	view := detailView{Title: job.Position + " at " + job.Company, Job: job, Statuses: domain.JobStatuses}
+	for _, event := range events {
+		view.Timeline = append(view.Timeline, timelineEntry{At: event.OccurredAt, Text: describeEvent(event)})
+	}
+	return view, nil
+}
+
+func fragment(c *gin.Context) {
+	c.Set(fragmentKey, true)
+}
+
+// render executes a page. Requests from app.js and fragment routes get the
+// named fragment, everything else the whole document.
+func (h *WebHandler) render(c *gin.Context, status int, page string, fragment string, data any) {
+	name := "layout"
+	if c.GetBool(fragmentKey) || c.GetHeader("HX-Request") != "" {
+		name = fragment
+	}
+	var body bytes.Buffer
+	if err := h.pages[page].ExecuteTemplate(&body, name, data); err != nil {
+		h.fail(c, "failed to execute template", err)
+		return
+	}
+	c.Data(status, "text/html; charset=utf-8", body.Bytes())
+}
+
+func (h *WebHandler) fail(c *gin.Context, message string, err error) {
+	h.logger.Error(c.Request.Context(), message, err)
+	problem := problemFor(err)
+	c.Data(problem.Status, "text/plain; charset=utf-8", []byte(cmp.Or(problem.Detail, problem.Title)))
+}
+
+func describeEvent(event domain.Event) string {
+	switch event.Type {
+	case domain.JobCreated:
+		return "Added"
+	case domain.JobUpdated:
+		return "Details updated"
+	case domain.JobStatusChanged:
+		if event.Job != nil {
+			return fmt.Sprintf("Status %s → %s", event.PreviousStatus, event.Job.Status)
+		}
+		return "Status changed"
+	case domain.JobDeleted:
+		return "Moved to the trash"
+	case domain.JobRestored:
+		return "Restored from the trash"
+	case domain.JobArchived:
+		return "Archived"
+	case domain.JobUnarchived:
+		return "Unarchived"
+	default:
+		return string(event.Type)
+	}
+}
+
+// inStatus is how long a job has been in its current status, for the cards
+// on the board.
+func inStatus(job *domain.Job) string {
+	since := job.CreatedAt
+	if job.StatusChangedAt != nil {
+		since = *job.StatusChangedAt
+	}
+	switch elapsed := time.Since(since); {
+	case elapsed < time.Hour:
+		return "just now"
+	case elapsed < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(elapsed.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(elapsed.Hours()/24))
+	}
+}
+
+func percent(n int, total int) int {
+	if total == 0 {
+		return 0
+	}
+	return n * 100 / total
+}
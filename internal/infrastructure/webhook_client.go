@@ -0,0 +1,36 @@
+package infrastructure
+
+import (
+	"context"
+	"io"
+	"job-tracker/internal/domain"
+	"net/http"
+	"time"
+)
+
+const DeliveryHeader = "X-Job-Tracker-Delivery"
+
+type HTTPWebhookClient struct {
+	client *http.Client
+}
+
+func NewWebhookClient() domain.WebhookClient {
+	return &HTTPWebhookClient{client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (c *HTTPWebhookClient) Post(ctx context.Context, subscription *domain.WebhookSubscription, delivery *domain.WebhookDelivery) (int, error) {
+	req, err := newSignedRequest(ctx, subscription.Url, subscription.Secret, string(delivery.EventType), []byte(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set(DeliveryHeader, delivery.Id.String())
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return resp.StatusCode, nil
+}
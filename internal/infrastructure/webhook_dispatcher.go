@@ -0,0 +1,40 @@
+package infrastructure
+
+import (
+	"context"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"time"
+)
+
+type WebhookDispatcher struct {
+	service  *application.WebhookService
+	log      domain.Logger
+	interval time.Duration
+}
+
+func NewWebhookDispatcher(service *application.WebhookService, log domain.Logger) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		service:  service,
+		log:      log,
+		interval: 5 * time.Second,
+	}
+}
+
+func (d *WebhookDispatcher) InitDispatch(ctx context.Context) error {
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			if err := d.service.DispatchDue(now, ctx); err != nil {
+				d.log.Error(ctx, "error dispatching webhooks", err)
+			}
+		case <-ctx.Done():
+			d.log.Info(ctx, "webhook dispatcher stopped")
+			return nil
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package infrastructure
+
+import (
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WebhookHandler struct {
+	service *application.WebhookService
+	logger  domain.Logger
+}
+
+func NewWebhookHandler(s *application.WebhookService, logger domain.Logger) *WebhookHandler {
+	return &WebhookHandler{service: s, logger: logger}
+}
+
+func (h *WebhookHandler) RegisterRoutes(r gin.IRouter) {
+	r.GET("/webhooks", h.GetWebhooks)
+	r.POST("/webhooks", h.CreateWebhook)
+	r.DELETE("/webhooks/:id", h.DeleteWebhook)
+	r.GET("/webhooks/:id/deliveries", h.GetDeliveries)
+	r.POST("/webhooks/:id/deliveries/:delivery/replay", h.ReplayDelivery)
+}
+
+func (h *WebhookHandler) GetWebhooks(c *gin.Context) {
+	h.logger.Info(c.Request.Context(), "getting webhooks")
+	subscriptions, err := h.service.GetSubscriptions(c.Request.Context())
+	isError := hasError(err, c)
+	if isError {
+		h.logger.Error(c.Request.Context(), "failed to get webhooks", err)
+		return
+	}
+	c.JSON(http.StatusOK, subscriptions)
+}
+
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	h.logger.Info(c.Request.Context(), "creating webhook")
+	var request application.CreateWebhookRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Error(c.Request.Context(), "invalid request body", err)
+		bindError(c, err)
+		return
+	}
+
+	subscription, err := h.service.CreateSubscription(&request, c.Request.Context())
+	isError := hasError(err, c)
+	if isError {
+		h.logger.Error(c.Request.Context(), "failed to create webhook", err)
+		return
+	}
+	h.logger.Info(c.Request.Context(), "webhook created successfully")
+	c.JSON(http.StatusCreated, subscription)
+}
+
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	h.logger.Info(c.Request.Context(), "deleting webhook")
+	id, ok := parseUUID(c, c.Param("id"))
+	if !ok {
+		return
+	}
+	err := h.service.DeleteSubscription(id.String(), c.Request.Context())
+	isError := hasError(err, c)
+	if isError {
+		h.logger.Error(c.Request.Context(), "failed to delete webhook", err)
+		return
+	}
+	h.logger.Info(c.Request.Context(), "webhook deleted successfully")
+	c.Status(http.StatusNoContent)
+}
+
+func (h *WebhookHandler) GetDeliveries(c *gin.Context) {
+	h.logger.Info(c.Request.Context(), "getting webhook deliveries")
+	id, ok := parseUUID(c, c.Param("id"))
+	if !ok {
+		return
+	}
+	deliveries, err := h.service.GetDeliveries(id.String(), c.Request.Context())
+	isError := hasError(err, c)
+	if isError {
+		h.logger.Error(c.Request.Context(), "failed to get webhook deliveries", err)
+		return
+	}
+	c.JSON(http.StatusOK, deliveries)
+}
+
+func (h *WebhookHandler) ReplayDelivery(c *gin.Context) {
+	h.logger.Info(c.Request.Context(), "replaying webhook delivery")
+	id, ok := parseUUID(c, c.Param("id"))
+	if !ok {
+		return
+	}
+	deliveryId, ok := parseUUID(c, c.Param("delivery"))
+	if !ok {
+		return
+	}
+	delivery, err := h.service.ReplayDelivery(id.String(), deliveryId.String(), c.Request.Context())
+	isError := hasError(err, c)
+	if isError {
+		h.logger.Error(c.Request.Context(), "failed to replay webhook delivery", err)
+		return
+	}
+	h.logger.Info(c.Request.Context(), "webhook delivery replayed successfully")
+	c.JSON(http.StatusAccepted, delivery)
+}
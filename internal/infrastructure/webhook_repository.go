@@ -0,0 +1,97 @@
+package infrastructure
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WebhookRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewWebhookRepository(db *gorm.DB) domain.WebhookRepository {
+	return &WebhookRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *WebhookRepositoryImpl) CreateSubscription(subscription *domain.WebhookSubscription, ctx context.Context) error {
+	return r.db.WithContext(ctx).Create(subscription).Error
+}
+
+func (r *WebhookRepositoryImpl) GetSubscriptionById(id string, ctx context.Context) (*domain.WebhookSubscription, error) {
+	var subscription domain.WebhookSubscription
+	err := r.db.WithContext(ctx).First(&subscription, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+func (r *WebhookRepositoryImpl) GetSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	var subscriptions []*domain.WebhookSubscription
+	err := r.db.WithContext(ctx).Order("created_at").Find(&subscriptions).Error
+	if err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+func (r *WebhookRepositoryImpl) DeleteSubscription(id string, ctx context.Context) error {
+	result := r.db.WithContext(ctx).Delete(&domain.WebhookSubscription{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrWebhookNotFound
+	}
+	return nil
+}
+
+// CreateDelivery skips a delivery already queued for the same subscription
+// and event.
+func (r *WebhookRepositoryImpl) CreateDelivery(delivery *domain.WebhookDelivery, ctx context.Context) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "subscription_id"}, {Name: "event_id"}},
+		DoNothing: true,
+	}).Create(delivery).Error
+}
+
+func (r *WebhookRepositoryImpl) UpdateDelivery(delivery *domain.WebhookDelivery, ctx context.Context) error {
+	return r.db.WithContext(ctx).Save(delivery).Error
+}
+
+func (r *WebhookRepositoryImpl) GetDeliveryById(id string, ctx context.Context) (*domain.WebhookDelivery, error) {
+	var delivery domain.WebhookDelivery
+	err := r.db.WithContext(ctx).First(&delivery, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+func (r *WebhookRepositoryImpl) GetDeliveriesBySubscription(subscriptionId string, ctx context.Context) ([]*domain.WebhookDelivery, error) {
+	var deliveries []*domain.WebhookDelivery
+	err := r.db.WithContext(ctx).Where("subscription_id = ?", subscriptionId).Order("created_at desc").Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (r *WebhookRepositoryImpl) GetDueDeliveries(now time.Time, limit int, ctx context.Context) ([]*domain.WebhookDelivery, error) {
+	var deliveries []*domain.WebhookDelivery
+	err := r.db.WithContext(ctx).
+		Where("status IN ? AND next_attempt_at <= ?", []domain.WebhookDeliveryStatus{domain.WebhookDeliveryPending, domain.WebhookDeliveryRetrying}, now).
+		Order("created_at").
+		Limit(limit).
+		Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
@@ -0,0 +1,320 @@
+// Package tui is a kanban board of job applications for the terminal.
+package tui
+
+import (
+	"context"
+	"errors"
+	"job-tracker/internal/client"
+	"job-tracker/internal/domain"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/google/uuid"
+)
+
+type mode int
+
+const (
+	modeBoard mode = iota
+	modeSearch
+	modeDetail
+	modeNotes
+)
+
+// Model is the board. Cards are grouped into one column per status and kept
+// in sync with the event stream.
+type Model struct {
+	backend client.Backend
+	ctx     context.Context
+
+	events  <-chan domain.Event
+	jobs    map[uuid.UUID]*domain.Job
+	columns [][]*domain.Job
+	column  int
+	row     int
+	open    uuid.UUID
+
+	mode   mode
+	search textinput.Model
+	notes  textarea.Model
+	detail viewport.Model
+	help   help.Model
+
+	message string
+	err     error
+	width   int
+	height  int
+}
+
+func New(backend client.Backend, ctx context.Context) Model {
+	search := textinput.New()
+	search.Prompt = "/ "
+	search.Placeholder = "company, position, tag…"
+
+	notes := textarea.New()
+	notes.Placeholder = "Notes about this application"
+	notes.ShowLineNumbers = false
+
+	return Model{
+		backend: backend,
+		ctx:     ctx,
+		jobs:    make(map[uuid.UUID]*domain.Job),
+		columns: make([][]*domain.Job, len(domain.JobStatuses)),
+		search:  search,
+		notes:   notes,
+		detail:  viewport.New(0, 0),
+		help:    help.New(),
+	}
+}
+
+// Run shows the board until the user quits.
+func Run(backend client.Backend) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, err := tea.NewProgram(New(backend, ctx), tea.WithAltScreen()).Run()
+	return err
+}
+
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(loadJobs(m.backend, m.ctx), watch(m.backend, m.ctx))
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.help.Width = msg.Width
+		m.notes.SetWidth(msg.Width - 2)
+		m.notes.SetHeight(max(msg.Height/3, 3))
+		m.detail.Width = msg.Width
+		m.detail.Height = max(msg.Height-2, 1)
+		m.renderDetail()
+		return m, nil
+	case jobsLoadedMsg:
+		m.jobs = make(map[uuid.UUID]*domain.Job, len(msg.jobs))
+		for _, job := range msg.jobs {
+			m.jobs[job.Id] = job
+		}
+		m.err = nil
+		m.regroup()
+		return m, nil
+	case jobSavedMsg:
+		m.put(msg.job)
+		m.err = nil
+		m.message = "saved " + msg.job.Position + " at " + msg.job.Company
+		m.regroup()
+		return m, nil
+	case watchStartedMsg:
+		m.events = msg.events
+		return m, nextEvent(m.events)
+	case eventMsg:
+		m.apply(msg.event)
+		m.regroup()
+		return m, nextEvent(m.events)
+	case watchEndedMsg:
+		return m, nil
+	case errMsg:
+		m.err = msg.err
+		if errors.Is(msg.err, domain.ErrVersionConflict) {
+			// Someone else changed the job: reload and let the user retry.
+			return m, loadJobs(m.backend, m.ctx)
+		}
+		return m, nil
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	}
+	return m, nil
+}
+
+func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch m.mode {
+	case modeSearch:
+		switch msg.String() {
+		case "enter":
+			m.mode = modeBoard
+			m.search.Blur()
+			return m, nil
+		case "esc":
+			m.mode = modeBoard
+			m.search.Blur()
+			m.search.SetValue("")
+			m.regroup()
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.search, cmd = m.search.Update(msg)
+		m.regroup()
+		return m, cmd
+
+	case modeNotes:
+		switch {
+		case key.Matches(msg, keys.Save):
+			m.mode = modeDetail
+			m.notes.Blur()
+			job := m.jobs[m.open]
+			if job == nil {
+				return m, nil
+			}
+			return m, saveNotes(m.backend, job.Id, m.notes.Value(), job.Version, m.ctx)
+		case key.Matches(msg, keys.Back):
+			m.mode = modeDetail
+			m.notes.Blur()
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.notes, cmd = m.notes.Update(msg)
+		return m, cmd
+
+	case modeDetail:
+		job := m.jobs[m.open]
+		switch {
+		case key.Matches(msg, keys.Back), msg.String() == "q":
+			m.mode = modeBoard
+			return m, nil
+		case key.Matches(msg, keys.Notes) && job != nil:
+			m.mode = modeNotes
+			m.notes.SetValue(job.Notes)
+			return m, m.notes.Focus()
+		case key.Matches(msg, keys.MoveLeft) && job != nil:
+			return m, m.move(job, -1)
+		case key.Matches(msg, keys.MoveRight) && job != nil:
+			return m, m.move(job, 1)
+		}
+		var cmd tea.Cmd
+		m.detail, cmd = m.detail.Update(msg)
+		return m, cmd
+	}
+
+	switch {
+	case key.Matches(msg, keys.Quit):
+		return m, tea.Quit
+	case key.Matches(msg, keys.Up):
+		m.row = max(m.row-1, 0)
+	case key.Matches(msg, keys.Down):
+		m.row = min(m.row+1, max(len(m.columns[m.column])-1, 0))
+	case key.Matches(msg, keys.Left):
+		m.focusColumn(m.column - 1)
+	case key.Matches(msg, keys.Right):
+		m.focusColumn(m.column + 1)
+	case key.Matches(msg, keys.MoveLeft):
+		if job := m.selected(); job != nil {
+			return m, m.move(job, -1)
+		}
+	case key.Matches(msg, keys.MoveRight):
+		if job := m.selected(); job != nil {
+			return m, m.move(job, 1)
+		}
+	case key.Matches(msg, keys.Open):
+		if job := m.selected(); job != nil {
+			m.open = job.Id
+			m.mode = modeDetail
+			m.renderDetail()
+			m.detail.GotoTop()
+		}
+	case key.Matches(msg, keys.Search):
+		m.mode = modeSearch
+		return m, m.search.Focus()
+	case key.Matches(msg, keys.Refresh):
+		return m, loadJobs(m.backend, m.ctx)
+	case key.Matches(msg, keys.Back):
+		if m.search.Value() != "" {
+			m.search.SetValue("")
+			m.regroup()
+		}
+	}
+	return m, nil
+}
+
+// move changes the status of job to the one next to it on the board.
+func (m Model) move(job *domain.Job, offset int) tea.Cmd {
+	target := slices.Index(domain.JobStatuses, job.Status) + offset
+	if target < 0 || target >= len(domain.JobStatuses) {
+		return nil
+	}
+	return moveJob(m.backend, job, domain.JobStatuses[target], m.ctx)
+}
+
+func (m *Model) apply(event domain.Event) {
+	switch {
+	case event.Type == domain.JobDeleted, event.Job != nil && event.Job.Archived:
+		delete(m.jobs, event.JobId)
+	case event.Job != nil:
+		m.put(event.Job)
+	}
+}
+
+// put keeps the newest copy of a job, since saves and events can arrive in
+// either order.
+func (m *Model) put(job *domain.Job) {
+	if current, ok := m.jobs[job.Id]; ok && current.Version > job.Version {
+		return
+	}
+	m.jobs[job.Id] = job
+}
+
+// regroup rebuilds the columns from the jobs that match the search, keeping
+// the selected card under the cursor when it is still visible.
+func (m *Model) regroup() {
+	selected := m.selected()
+
+	query := strings.ToLower(strings.TrimSpace(m.search.Value()))
+	columns := make([][]*domain.Job, len(domain.JobStatuses))
+	for _, job := range m.jobs {
+		column := slices.Index(domain.JobStatuses, job.Status)
+		if column < 0 || !matches(job, query) {
+			continue
+		}
+		columns[column] = append(columns[column], job)
+	}
+	for _, column := range columns {
+		slices.SortFunc(column, func(a, b *domain.Job) int {
+			return b.UpdatedAt.Compare(a.UpdatedAt)
+		})
+	}
+	m.columns = columns
+
+	if selected != nil {
+		if job, ok := m.jobs[selected.Id]; ok {
+			column := slices.Index(domain.JobStatuses, job.Status)
+			if row := slices.Index(m.columns[max(column, 0)], job); column >= 0 && row >= 0 {
+				m.column, m.row = column, row
+			}
+		}
+	}
+	m.row = min(m.row, max(len(m.columns[m.column])-1, 0))
+	m.renderDetail()
+}
+
+func (m *Model) focusColumn(column int) {
+	if column < 0 || column >= len(m.columns) {
+		return
+	}
+	m.column = column
+	m.row = min(m.row, max(len(m.columns[column])-1, 0))
+}
+
+func (m Model) selected() *domain.Job {
+	if m.column >= len(m.columns) || m.row >= len(m.columns[m.column]) {
+		return nil
+	}
+	return m.columns[m.column][m.row]
+}
+
+func matches(job *domain.Job, query string) bool {
+	if query == "" {
+		return true
+	}
+	fields := append([]string{job.Company, job.Position, job.Description, job.Notes}, job.Tags...)
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), query) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package tui
+
+import (
+	"context"
+	"job-tracker/internal/application"
+	"job-tracker/internal/client"
+	"job-tracker/internal/domain"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/google/uuid"
+)
+
+type jobsLoadedMsg struct {
+	jobs []*domain.Job
+}
+
+type jobSavedMsg struct {
+	job *domain.Job
+}
+
+type watchStartedMsg struct {
+	events <-chan domain.Event
+}
+
+type eventMsg struct {
+	event domain.Event
+}
+
+type watchEndedMsg struct{}
+
+type errMsg struct {
+	err error
+}
+
+func loadJobs(backend client.Backend, ctx context.Context) tea.Cmd {
+	return func() tea.Msg {
+		jobs, err := backend.ListJobs("", false, ctx)
+		if err != nil {
+			return errMsg{err}
+		}
+		return jobsLoadedMsg{jobs}
+	}
+}
+
+func moveJob(backend client.Backend, job *domain.Job, status domain.JobStatus, ctx context.Context) tea.Cmd {
+	return func() tea.Msg {
+		updated, err := backend.UpdateJobStatus(job.Id, &application.UpdateJobStatusRequest{
+			Status:  string(status),
+			Version: job.Version,
+		}, ctx)
+		if err != nil {
+			return errMsg{err}
+		}
+		return jobSavedMsg{updated}
+	}
+}
+
+func saveNotes(backend client.Backend, id uuid.UUID, notes string, version int, ctx context.Context) tea.Cmd {
+	return func() tea.Msg {
+		updated, err := backend.UpdateNotes(id, notes, version, ctx)
+		if err != nil {
+			return errMsg{err}
+		}
+		return jobSavedMsg{updated}
+	}
+}
+
+func watch(backend client.Backend, ctx context.Context) tea.Cmd {
+	return func() tea.Msg {
+		events, err := backend.Watch(ctx)
+		if err != nil {
+			return errMsg{err}
+		}
+		return watchStartedMsg{events}
+	}
+}
+
+func nextEvent(events <-chan domain.Event) tea.Cmd {
+	return func() tea.Msg {
+		event, ok := <-events
+		if !ok {
+			return watchEndedMsg{}
+		}
+		return eventMsg{event}
+	}
+}
@@ -0,0 +1,66 @@
+package tui
+
+import "github.com/charmbracelet/bubbles/key"
+
+type keyMap struct {
+	Up        key.Binding
+	Down      key.Binding
+	Left      key.Binding
+	Right     key.Binding
+	MoveLeft  key.Binding
+	MoveRight key.Binding
+	Open      key.Binding
+	Notes     key.Binding
+	Save      key.Binding
+	Search    key.Binding
+	Refresh   key.Binding
+	Back      key.Binding
+	Quit      key.Binding
+}
+
+var keys = keyMap{
+	Up:        key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+	Down:      key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+	Left:      key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←/h", "prev column")),
+	Right:     key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→/l", "next column")),
+	MoveLeft:  key.NewBinding(key.WithKeys("shift+left", "H", "<"), key.WithHelp("H", "move card left")),
+	MoveRight: key.NewBinding(key.WithKeys("shift+right", "L", ">"), key.WithHelp("L", "move card right")),
+	Open:      key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "open")),
+	Notes:     key.NewBinding(key.WithKeys("n"), key.WithHelp("n", "edit notes")),
+	Save:      key.NewBinding(key.WithKeys("ctrl+s"), key.WithHelp("ctrl+s", "save")),
+	Search:    key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "search")),
+	Refresh:   key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "refresh")),
+	Back:      key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	Quit:      key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q", "quit")),
+}
+
+// boardKeys, detailKeys and notesKeys implement help.KeyMap for each mode.
+type boardKeys struct{}
+
+func (boardKeys) ShortHelp() []key.Binding {
+	return []key.Binding{keys.Left, keys.Right, keys.MoveLeft, keys.MoveRight, keys.Open, keys.Search, keys.Refresh, keys.Quit}
+}
+
+func (k boardKeys) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp()}
+}
+
+type detailKeys struct{}
+
+func (detailKeys) ShortHelp() []key.Binding {
+	return []key.Binding{keys.Up, keys.Down, keys.MoveLeft, keys.MoveRight, keys.Notes, keys.Back}
+}
+
+func (k detailKeys) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp()}
+}
+
+type notesKeys struct{}
+
+func (notesKeys) ShortHelp() []key.Binding {
+	return []key.Binding{keys.Save, keys.Back}
+}
+
+func (k notesKeys) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp()}
+}
@@ -0,0 +1,121 @@
+package tui
+
+import (
+	"fmt"
+	"job-tracker/internal/domain"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	headerStyle   = lipgloss.NewStyle().Bold(true).Padding(0, 1)
+	columnStyle   = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(lipgloss.Color("240"))
+	focusedStyle  = columnStyle.BorderForeground(lipgloss.Color("63"))
+	cardStyle     = lipgloss.NewStyle().Padding(0, 1)
+	selectedStyle = cardStyle.Background(lipgloss.Color("63")).Foreground(lipgloss.Color("230"))
+	mutedStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+	errorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("203"))
+	titleStyle    = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("63"))
+)
+
+func (m Model) View() string {
+	switch m.mode {
+	case modeDetail:
+		return lipgloss.JoinVertical(lipgloss.Left, m.detail.View(), m.footer(detailKeys{}))
+	case modeNotes:
+		job := m.jobs[m.open]
+		title := "Notes"
+		if job != nil {
+			title = "Notes · " + job.Position + " at " + job.Company
+		}
+		return lipgloss.JoinVertical(lipgloss.Left, titleStyle.Render(title), m.notes.View(), m.footer(notesKeys{}))
+	}
+
+	var top string
+	if m.mode == modeSearch || m.search.Value() != "" {
+		top = m.search.View()
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, top, m.board(), m.footer(boardKeys{}))
+}
+
+func (m Model) board() string {
+	count := len(domain.JobStatuses)
+	width := max(m.width/count-2, 12)
+	height := max(m.height-6, 3)
+
+	columns := make([]string, count)
+	for i, status := range domain.JobStatuses {
+		lines := []string{headerStyle.Render(fmt.Sprintf("%s (%d)", status, len(m.columns[i])))}
+		for j, job := range m.columns[i] {
+			style := cardStyle
+			if i == m.column && j == m.row {
+				style = selectedStyle
+			}
+			lines = append(lines, style.Width(width).Render(truncate(job.Company, width-2)+"\n"+truncate(job.Position, width-2)))
+		}
+
+		style := columnStyle
+		if i == m.column {
+			style = focusedStyle
+		}
+		columns[i] = style.Width(width).Height(height).MaxHeight(height + 2).Render(strings.Join(lines, "\n"))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, columns...)
+}
+
+func (m Model) footer(keyMap help.KeyMap) string {
+	status := mutedStyle.Render(m.message)
+	if m.err != nil {
+		status = errorStyle.Render("error: " + m.err.Error())
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, status, m.help.View(keyMap))
+}
+
+// renderDetail refreshes the content of the open job, which may have been
+// changed by an event while it is on screen.
+func (m *Model) renderDetail() {
+	job := m.jobs[m.open]
+	if job == nil {
+		m.detail.SetContent(mutedStyle.Render("This job is no longer on the board."))
+		return
+	}
+
+	wrap := lipgloss.NewStyle().Width(max(m.detail.Width-2, 20))
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(job.Position+" at "+job.Company) + "\n\n")
+	rows := [][2]string{
+		{"Status", string(job.Status)},
+		{"Salary", strconv.Itoa(job.Salary)},
+		{"Remote", strconv.FormatBool(job.Remote)},
+		{"URL", job.Url},
+		{"Tags", strings.Join(job.Tags, ", ")},
+		{"Updated", job.UpdatedAt.Local().Format(time.DateTime)},
+	}
+	for _, row := range rows {
+		b.WriteString(mutedStyle.Render(fmt.Sprintf("%-8s", row[0])) + " " + row[1] + "\n")
+	}
+	b.WriteString("\n" + headerStyle.Padding(0).Render("Description") + "\n")
+	b.WriteString(wrap.Render(job.Description) + "\n")
+	b.WriteString("\n" + headerStyle.Padding(0).Render("Notes") + "\n")
+	if job.Notes == "" {
+		b.WriteString(mutedStyle.Render("No notes yet, press n to add some.") + "\n")
+	} else {
+		b.WriteString(wrap.Render(job.Notes) + "\n")
+	}
+	m.detail.SetContent(b.String())
+}
+
+func truncate(value string, width int) string {
+	if width <= 1 || lipgloss.Width(value) <= width {
+		return value
+	}
+	runes := []rune(value)
+	for len(runes) > 0 && lipgloss.Width(string(runes)) > width-1 {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "…"
+}
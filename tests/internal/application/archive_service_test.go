@@ -0,0 +1,113 @@
+package application
+
+import (
+	"context"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"job-tracker/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func InitArchiveTest() (*mocks.JobRepositoryMock, *application.ArchiveService) {
+	var repo = new(mocks.JobRepositoryMock)
+	return repo, application.NewArchiveService(repo, &mocks.LoggerMock{})
+}
+
+func rejectedSince(at time.Time) *domain.Job {
+	job := domain.NewJob("Google", "Backend", "Go dev", 100000, true, "")
+	job.ChangeStatus(domain.JobStatusRejected)
+	job.StatusChangedAt = &at
+	job.PullEvents()
+	return job
+}
+
+func TestApplyRules_ArchivesStaleRejections(t *testing.T) {
+
+	repo, service := InitArchiveTest()
+
+	now := time.Now()
+	stale := rejectedSince(now.AddDate(0, 0, -31))
+	recent := rejectedSince(now.AddDate(0, 0, -10))
+	unarchived := rejectedSince(now.AddDate(0, 0, -40))
+	unarchived.Archive(domain.FieldSourceUser)
+	unarchived.Unarchive()
+
+	repo.On("GetJobsByStatus", domain.JobStatusRejected, false).Return([]*domain.Job{stale, recent, unarchived}, nil)
+	repo.On("GetJobsByStatus", domain.JobStatusClosed, false).Return([]*domain.Job{}, nil)
+	repo.On("GetAll", false).Return([]*domain.Job{}, nil)
+	repo.On("UpdateJob", stale).Return(nil)
+
+	err := service.ApplyRules(now, context.Background())
+
+	assert.NoError(t, err)
+	assert.True(t, stale.Archived)
+	assert.False(t, recent.Archived)
+	assert.False(t, unarchived.Archived)
+	repo.AssertNumberOfCalls(t, "UpdateJob", 1)
+}
+
+func TestApplyRules_ArchivesClosedPostingsWhateverTheStatus(t *testing.T) {
+
+	repo, service := InitArchiveTest()
+
+	interview := domain.NewJob("Google", "Backend", "Go dev", 100000, true, "")
+	interview.ChangeStatus(domain.JobStatusInterview)
+	assert.True(t, interview.ApplyScraped("", domain.JobStatusClosed))
+	interview.PullEvents()
+	open := domain.NewJob("Meta", "Frontend", "React", 100000, true, "")
+
+	repo.On("GetJobsByStatus", domain.JobStatusRejected, false).Return([]*domain.Job{}, nil)
+	repo.On("GetJobsByStatus", domain.JobStatusClosed, false).Return([]*domain.Job{}, nil)
+	repo.On("GetAll", false).Return([]*domain.Job{interview, open}, nil)
+	repo.On("UpdateJob", interview).Return(nil)
+
+	err := service.ApplyRules(time.Now(), context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.JobStatusInterview, interview.Status)
+	assert.True(t, interview.Archived)
+	assert.False(t, open.Archived)
+	repo.AssertNumberOfCalls(t, "UpdateJob", 1)
+}
+
+func TestArchiveJobs_UnknownIdChangesNothing(t *testing.T) {
+
+	repo, service := InitArchiveTest()
+
+	job := domain.NewJob("Google", "Backend", "Go dev", 100000, true, "")
+	missing := uuid.New()
+
+	repo.On("GetJobById", job.Id.String()).Return(job, nil)
+	repo.On("GetJobById", missing.String()).Return(nil, domain.ErrJobNotFound)
+
+	_, err := service.ArchiveJobs(&application.ArchiveJobsRequest{Ids: []uuid.UUID{job.Id, missing}}, context.Background())
+
+	assert.ErrorIs(t, err, domain.ErrJobNotFound)
+	assert.False(t, job.Archived)
+	repo.AssertNotCalled(t, "UpdateJob", mock.Anything)
+}
+
+func TestUnarchiveJobs(t *testing.T) {
+
+	repo, service := InitArchiveTest()
+
+	job := domain.NewJob("Google", "Backend", "Go dev", 100000, true, "")
+	job.Archive(domain.FieldSourceRule)
+	job.PullEvents()
+
+	repo.On("GetJobById", job.Id.String()).Return(job, nil)
+	repo.On("UpdateJob", job).Return(nil)
+
+	jobs, err := service.UnarchiveJobs(&application.ArchiveJobsRequest{Ids: []uuid.UUID{job.Id}}, context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 1)
+	assert.False(t, job.Archived)
+	assert.True(t, job.IsUserOwned(domain.JobFieldArchived))
+	assert.Equal(t, domain.JobUnarchived, job.PullEvents()[0].Type)
+}
@@ -7,16 +7,22 @@ import (
 	"job-tracker/internal/domain"
 	"job-tracker/tests/mocks"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 func InitAppTest() (*mocks.JobRepositoryMock, *application.JobService) {
 	var repo = new(mocks.JobRepositoryMock)
 	var logger = &mocks.LoggerMock{}
-	return repo, application.NewJobService(repo, logger)
+	return repo, application.NewJobService(repo, logger, noop.NewTracerProvider())
 }
 
 func TestCreateJob(t *testing.T) {
@@ -42,6 +48,21 @@ func TestCreateJob(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
+func TestCreateJob_RecordsEvent(t *testing.T) {
+
+	repo, service := InitAppTest()
+
+	repo.On("CreateJob", mock.AnythingOfType("*domain.Job")).Return(nil)
+
+	job, err := service.CreateJob(&application.CreateJobRequest{Company: "Google", Position: "Backend", Description: "Go dev"}, context.Background())
+
+	assert.NoError(t, err)
+	events := job.PullEvents()
+	assert.Len(t, events, 1)
+	assert.Equal(t, domain.JobCreated, events[0].Type)
+	assert.Equal(t, job.Id, events[0].JobId)
+}
+
 func TestUpdateJob(t *testing.T) {
 
 	repo, service := InitAppTest()
@@ -69,6 +90,110 @@ func TestUpdateJob(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
+func TestUpdateJob_StaleVersion(t *testing.T) {
+
+	repo, service := InitAppTest()
+
+	existingJob := domain.NewJob("OldCo", "OldPos", "OldDesc", 90000, false, "")
+	existingJob.Version = 3
+	req := &application.UpdateJobRequest{Id: existingJob.Id, Company: "Amazon", Position: "Go Dev", Description: "Backend work", Version: 2}
+
+	repo.On("GetJobById", existingJob.Id.String()).Return(existingJob, nil)
+
+	job, err := service.UpdateJob(req, context.Background())
+
+	assert.ErrorIs(t, err, domain.ErrVersionConflict)
+	assert.Nil(t, job)
+	repo.AssertNotCalled(t, "UpdateJob", existingJob)
+}
+
+func TestPatchJob_MergePatch(t *testing.T) {
+
+	repo, service := InitAppTest()
+
+	existingJob := domain.NewJob("Google", "Backend", "Scraped description", 100000, true, "https://jobs.example.com/1")
+	repo.On("GetJobById", existingJob.Id.String()).Return(existingJob, nil)
+	repo.On("UpdateJob", existingJob).Return(nil)
+
+	request := &application.PatchJobRequest{
+		Format: application.PatchFormatMerge,
+		Patch:  []byte(`{"description":"Hand-corrected description","status":"applied"}`),
+	}
+	job, err := service.PatchJob(existingJob.Id, request, context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Hand-corrected description", job.Description)
+	assert.Equal(t, domain.JobStatusApplied, job.Status)
+	assert.Equal(t, "Google", job.Company)
+	assert.True(t, job.IsUserOwned(domain.JobFieldDescription))
+	assert.False(t, job.IsUserOwned(domain.JobFieldCompany))
+
+	assert.True(t, job.ApplyScraped("New scraped description", domain.JobStatusClosed))
+	assert.Equal(t, "Hand-corrected description", job.Description)
+	assert.Equal(t, domain.JobStatusApplied, job.Status)
+	assert.NotNil(t, job.PostingClosedAt)
+	repo.AssertExpectations(t)
+}
+
+func TestPatchJob_JSONPatch(t *testing.T) {
+
+	repo, service := InitAppTest()
+
+	existingJob := domain.NewJob("Google", "Backend", "Go dev", 100000, true, "")
+	repo.On("GetJobById", existingJob.Id.String()).Return(existingJob, nil)
+	repo.On("UpdateJob", existingJob).Return(nil)
+
+	request := &application.PatchJobRequest{
+		Format: application.PatchFormatJSON,
+		Patch:  []byte(`[{"op":"test","path":"/salary","value":100000},{"op":"replace","path":"/salary","value":120000}]`),
+	}
+	job, err := service.PatchJob(existingJob.Id, request, context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 120000, job.Salary)
+	assert.True(t, job.IsUserOwned(domain.JobFieldSalary))
+	repo.AssertExpectations(t)
+}
+
+func TestPatchJob_Notes(t *testing.T) {
+
+	repo, service := InitAppTest()
+
+	existingJob := domain.NewJob("Google", "Backend", "Go dev", 100000, true, "")
+	existingJob.PullEvents()
+	repo.On("GetJobById", existingJob.Id.String()).Return(existingJob, nil)
+	repo.On("UpdateJob", existingJob).Return(nil)
+
+	request := &application.PatchJobRequest{
+		Format: application.PatchFormatMerge,
+		Patch:  []byte(`{"notes":"Recruiter is Ana","salary":110000}`),
+	}
+	job, err := service.PatchJob(existingJob.Id, request, context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Recruiter is Ana", job.Notes)
+	assert.True(t, job.IsUserOwned(domain.JobFieldNotes))
+	events := job.PullEvents()
+	assert.Len(t, events, 1)
+	assert.Equal(t, domain.JobUpdated, events[0].Type)
+	repo.AssertExpectations(t)
+}
+
+func TestPatchJob_Invalid(t *testing.T) {
+
+	repo, service := InitAppTest()
+
+	existingJob := domain.NewJob("Google", "Backend", "Go dev", 100000, true, "")
+	repo.On("GetJobById", existingJob.Id.String()).Return(existingJob, nil)
+
+	for _, patch := range []string{`{"company":null}`, `{"unknown":1}`, `{"status":"maybe"}`, `not json`} {
+		job, err := service.PatchJob(existingJob.Id, &application.PatchJobRequest{Format: application.PatchFormatMerge, Patch: []byte(patch)}, context.Background())
+		assert.ErrorIs(t, err, domain.ErrInvalidRequest, patch)
+		assert.Nil(t, job)
+	}
+	repo.AssertNotCalled(t, "UpdateJob", existingJob)
+}
+
 func TestUpdateJob_NotFound(t *testing.T) {
 
 	repo, service := InitAppTest()
@@ -85,6 +210,38 @@ func TestUpdateJob_NotFound(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
+func TestUpdateJobStatus_RecordsEvent(t *testing.T) {
+
+	repo, service := InitAppTest()
+
+	existingJob := domain.NewJob("Google", "Backend", "Go dev", 100000, true, "")
+	existingJob.PullEvents()
+
+	repo.On("GetJobById", existingJob.Id.String()).Return(existingJob, nil)
+	repo.On("UpdateJob", existingJob).Return(nil)
+
+	job, err := service.UpdateJobStatus(existingJob.Id, &application.UpdateJobStatusRequest{Status: "applied"}, context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.JobStatusApplied, job.Status)
+	events := job.PullEvents()
+	assert.Len(t, events, 1)
+	assert.Equal(t, domain.JobStatusChanged, events[0].Type)
+	assert.Equal(t, domain.JobStatusPending, events[0].PreviousStatus)
+	repo.AssertExpectations(t)
+}
+
+func TestUpdateJobStatus_Invalid(t *testing.T) {
+
+	repo, service := InitAppTest()
+
+	job, err := service.UpdateJobStatus(uuid.New(), &application.UpdateJobStatusRequest{Status: "nope"}, context.Background())
+
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+	assert.Nil(t, job)
+	repo.AssertExpectations(t)
+}
+
 func TestDeleteJob(t *testing.T) {
 
 	repo, service := InitAppTest()
@@ -147,9 +304,9 @@ func TestGetAllJobs(t *testing.T) {
 		domain.NewJob("B", "Y", "desc", 2, true, ""),
 	}
 
-	repo.On("GetAll").Return(jobs, nil)
+	repo.On("GetAll", false).Return(jobs, nil)
 
-	result, err := service.GetAllJobs(context.Background())
+	result, err := service.GetAllJobs(false, context.Background())
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 	repo.AssertExpectations(t)
@@ -163,9 +320,9 @@ func TestGetJobsByStatus(t *testing.T) {
 	jobs := []*domain.Job{
 		domain.NewJob("A", "X", "desc", 1, false, ""),
 	}
-	repo.On("GetJobsByStatus", status).Return(jobs, nil)
+	repo.On("GetJobsByStatus", status, false).Return(jobs, nil)
 
-	result, err := service.GetJobsByStatus(status, context.Background())
+	result, err := service.GetJobsByStatus(status, false, context.Background())
 	assert.NoError(t, err)
 	assert.Len(t, result, 1)
 	repo.AssertExpectations(t)
@@ -175,10 +332,68 @@ func TestGetJobsByStatus_Error(t *testing.T) {
 
 	repo, service := InitAppTest()
 	status := domain.JobStatusOpen
-	repo.On("GetJobsByStatus", status).Return([]*domain.Job{}, errors.New("db error"))
+	repo.On("GetJobsByStatus", status, false).Return([]*domain.Job{}, errors.New("db error"))
 
-	result, err := service.GetJobsByStatus(status, context.Background())
+	result, err := service.GetJobsByStatus(status, false, context.Background())
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	repo.AssertExpectations(t)
 }
+
+func TestStats(t *testing.T) {
+
+	repo, service := InitAppTest()
+
+	now := time.Date(2024, 5, 15, 12, 0, 0, 0, time.UTC) // a Wednesday
+	google := domain.NewJob("Google", "Backend", "Go dev", 100000, true, "")
+	google.CreatedAt = now.AddDate(0, 0, -1)
+	google.ChangeStatus(domain.JobStatusApplied)
+	amazon := domain.NewJob("Amazon", "SRE", "Ops", 80000, false, "")
+	amazon.CreatedAt = now.AddDate(0, 0, -7)
+	amazon.Archived = true
+	old := domain.NewJob("Acme", "Dev", "Old", 0, false, "")
+	old.CreatedAt = now.AddDate(0, -6, 0)
+
+	repo.On("GetAll", true).Return([]*domain.Job{google, amazon, old}, nil)
+
+	stats, err := service.Stats(true, now, context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, stats.Total)
+	assert.Equal(t, 1, stats.Archived)
+	assert.Equal(t, 1, stats.Remote)
+	assert.Equal(t, 90000, stats.AverageSalary)
+	assert.Contains(t, stats.ByStatus, application.StatusCount{Status: domain.JobStatusApplied, Count: 1})
+	assert.Contains(t, stats.ByStatus, application.StatusCount{Status: domain.JobStatusPending, Count: 2})
+	assert.Len(t, stats.Weekly, 8)
+	assert.Equal(t, time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC), stats.Weekly[7].Start)
+	assert.Equal(t, 1, stats.Weekly[7].Count)
+	assert.Equal(t, 1, stats.Weekly[6].Count)
+}
+
+func TestJobService_RecordsSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	repo := new(mocks.JobRepositoryMock)
+	service := application.NewJobService(repo, &mocks.LoggerMock{}, sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	missing := uuid.New()
+	repo.On("GetJobById", missing.String()).Return(nil, errors.New("record not found"))
+	repo.On("GetAll", false).Return([]*domain.Job{}, errors.New("connection refused"))
+
+	_, err := service.GetJob(missing, context.Background())
+	assert.ErrorIs(t, err, domain.ErrJobNotFound)
+	_, err = service.GetAllJobs(false, context.Background())
+	assert.Error(t, err)
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 2)
+
+	assert.Equal(t, "JobService.GetJob", spans[0].Name())
+	assert.Contains(t, spans[0].Attributes(), attribute.String("job.id", missing.String()))
+	assert.Len(t, spans[0].Events(), 1)
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+
+	assert.Equal(t, "JobService.GetAllJobs", spans[1].Name())
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Equal(t, "connection refused", spans[1].Status().Description)
+}
@@ -0,0 +1,122 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"job-tracker/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func InitNotificationTest() (*mocks.NotificationRepositoryMock, *mocks.NotificationSenderMock, *application.NotificationService) {
+	var repo = new(mocks.NotificationRepositoryMock)
+	var sender = &mocks.NotificationSenderMock{ChannelKind: domain.ChannelKindWebhook}
+	return repo, sender, application.NewNotificationService(repo, []domain.NotificationSender{sender}, &mocks.LoggerMock{})
+}
+
+func TestNotify_QueuesForSubscribedChannels(t *testing.T) {
+
+	repo, sender, service := InitNotificationTest()
+
+	subscribed := domain.NewNotificationChannel("alice", domain.ChannelKindWebhook, "http://hook", "s", []domain.NotificationEvent{domain.NotificationReminderDue})
+	other := domain.NewNotificationChannel("alice", domain.ChannelKindWebhook, "http://hook", "s", []domain.NotificationEvent{domain.NotificationScrapeFailed})
+	notification := domain.Notification{Event: domain.NotificationReminderDue, Subject: "Reminder", Message: "follow up", UserId: "alice"}
+
+	repo.On("GetEnabledChannels", "alice").Return([]*domain.NotificationChannel{subscribed, other}, nil)
+	repo.On("CreateDelivery", mock.MatchedBy(func(d *domain.NotificationDelivery) bool {
+		return d.ChannelId == subscribed.Id && d.Status == domain.DeliveryStatusPending && d.Message == "follow up"
+	})).Return(nil)
+
+	err := service.Notify(context.Background(), notification)
+
+	assert.NoError(t, err)
+	repo.AssertNumberOfCalls(t, "CreateDelivery", 1)
+	sender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+}
+
+func TestDispatchDue_RetriesThenFails(t *testing.T) {
+
+	repo, sender, service := InitNotificationTest()
+
+	channel := domain.NewNotificationChannel("alice", domain.ChannelKindWebhook, "http://hook", "s", []domain.NotificationEvent{domain.NotificationStatusChanged})
+	notification := domain.Notification{Event: domain.NotificationStatusChanged, Subject: "Status", Message: "APPLIED -> OFFER"}
+	delivery := domain.NewNotificationDelivery(channel.Id, notification)
+
+	now := time.Now()
+	repo.On("GetDueDeliveries", mock.Anything, mock.Anything).Return([]*domain.NotificationDelivery{delivery}, nil)
+	repo.On("GetChannelById", channel.Id.String()).Return(channel, nil)
+	repo.On("UpdateDelivery", delivery).Return(nil)
+	sender.On("Send", channel, notification).Return(errors.New("connection refused"))
+
+	assert.NoError(t, service.DispatchDue(now, context.Background()))
+	assert.Equal(t, domain.DeliveryStatusRetrying, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.True(t, delivery.NextAttemptAt.After(now))
+
+	for range 2 {
+		assert.NoError(t, service.DispatchDue(delivery.NextAttemptAt, context.Background()))
+	}
+	assert.Equal(t, domain.DeliveryStatusFailed, delivery.Status)
+	assert.Equal(t, 3, delivery.Attempts)
+	assert.Equal(t, "connection refused", delivery.LastError)
+	sender.AssertNumberOfCalls(t, "Send", 3)
+}
+
+func TestDispatchDue_Delivers(t *testing.T) {
+
+	repo, sender, service := InitNotificationTest()
+
+	channel := domain.NewNotificationChannel("alice", domain.ChannelKindWebhook, "http://hook", "s", []domain.NotificationEvent{domain.NotificationStatusChanged})
+	delivery := domain.NewNotificationDelivery(channel.Id, domain.Notification{Event: domain.NotificationStatusChanged, Subject: "Status"})
+
+	repo.On("GetDueDeliveries", mock.Anything, mock.Anything).Return([]*domain.NotificationDelivery{delivery}, nil)
+	repo.On("GetChannelById", channel.Id.String()).Return(channel, nil)
+	repo.On("UpdateDelivery", delivery).Return(nil)
+	sender.On("Send", channel, delivery.Notification()).Return(nil)
+
+	assert.NoError(t, service.DispatchDue(time.Now(), context.Background()))
+	assert.Equal(t, domain.DeliveryStatusDelivered, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.NotNil(t, delivery.DeliveredAt)
+}
+
+func TestHandle_NotifiesOnlyTheJobOwner(t *testing.T) {
+
+	repo, _, service := InitNotificationTest()
+
+	alice := domain.NewNotificationChannel("alice", domain.ChannelKindWebhook, "http://alice", "s", []domain.NotificationEvent{domain.NotificationStatusChanged})
+	bob := domain.NewNotificationChannel("bob", domain.ChannelKindWebhook, "http://bob", "s", []domain.NotificationEvent{domain.NotificationStatusChanged})
+	job := domain.NewJob("Google", "Backend", "Go dev", 0, false, "")
+	job.UserId = "alice"
+	job.ChangeStatus(domain.JobStatusApplied)
+	event := job.PullEvents()[1]
+
+	repo.On("GetEnabledChannels", "alice").Return([]*domain.NotificationChannel{alice}, nil)
+	repo.On("GetEnabledChannels", "bob").Return([]*domain.NotificationChannel{bob}, nil)
+	repo.On("CreateDelivery", mock.Anything).Return(nil)
+
+	assert.NoError(t, service.Handle(context.Background(), event))
+
+	repo.AssertNotCalled(t, "GetEnabledChannels", "bob")
+	repo.AssertNumberOfCalls(t, "CreateDelivery", 1)
+	delivery := repo.Calls[len(repo.Calls)-1].Arguments.Get(0).(*domain.NotificationDelivery)
+	assert.Equal(t, alice.Id, delivery.ChannelId)
+}
+
+func TestDeleteChannel_OtherUser(t *testing.T) {
+
+	repo, _, service := InitNotificationTest()
+
+	channel := domain.NewNotificationChannel("alice", domain.ChannelKindEmail, "alice@example.com", "", []domain.NotificationEvent{domain.NotificationReminderDue})
+	repo.On("GetChannelById", channel.Id.String()).Return(channel, nil)
+
+	err := service.DeleteChannel("bob", channel.Id.String(), context.Background())
+
+	assert.ErrorIs(t, err, domain.ErrChannelNotFound)
+	repo.AssertNotCalled(t, "DeleteChannel", channel.Id.String())
+}
@@ -0,0 +1,148 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"job-tracker/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func InitReminderTest() (*mocks.ReminderRepositoryMock, *mocks.JobRepositoryMock, *mocks.NotifierMock, *application.ReminderService) {
+	var repo = new(mocks.ReminderRepositoryMock)
+	var jobs = new(mocks.JobRepositoryMock)
+	var notifier = new(mocks.NotifierMock)
+	return repo, jobs, notifier, application.NewReminderService(repo, jobs, notifier, &mocks.LoggerMock{})
+}
+
+func TestFireDue_OneOff(t *testing.T) {
+
+	repo, jobs, notifier, service := InitReminderTest()
+
+	now := time.Now()
+	job := domain.NewJob("Google", "Backend", "Go dev", 100000, true, "")
+	reminder := domain.NewReminder(job.Id, now.Add(-time.Hour), "Follow up", domain.ReminderRecurrenceNone)
+
+	repo.On("GetDueBefore", now).Return([]*domain.Reminder{reminder}, nil)
+	jobs.On("GetJobById", job.Id.String()).Return(job, nil)
+	notifier.On("Notify", mock.AnythingOfType("domain.Notification")).Return(nil)
+	repo.On("UpdateReminder", reminder).Return(nil)
+
+	err := service.FireDue(now, context.Background())
+
+	assert.NoError(t, err)
+	assert.True(t, reminder.Done)
+	assert.NotNil(t, reminder.FiredAt)
+	repo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
+
+func TestFireDue_Recurring(t *testing.T) {
+
+	repo, jobs, notifier, service := InitReminderTest()
+
+	now := time.Now()
+	due := now.Add(-time.Hour)
+	job := domain.NewJob("A", "X", "desc", 1, false, "")
+	reminder := domain.NewReminder(job.Id, due, "Check inbox", domain.ReminderRecurrenceDaily)
+
+	repo.On("GetDueBefore", now).Return([]*domain.Reminder{reminder}, nil)
+	jobs.On("GetJobById", reminder.JobId.String()).Return(job, nil)
+	notifier.On("Notify", mock.AnythingOfType("domain.Notification")).Return(nil)
+	repo.On("UpdateReminder", reminder).Return(nil)
+
+	err := service.FireDue(now, context.Background())
+
+	assert.NoError(t, err)
+	assert.False(t, reminder.Done)
+	assert.Equal(t, due.AddDate(0, 0, 1), reminder.DueAt)
+	repo.AssertExpectations(t)
+}
+
+func TestFireDue_NotifierError(t *testing.T) {
+
+	repo, jobs, notifier, service := InitReminderTest()
+
+	now := time.Now()
+	job := domain.NewJob("A", "X", "desc", 1, false, "")
+	reminder := domain.NewReminder(job.Id, now, "Follow up", domain.ReminderRecurrenceNone)
+
+	repo.On("GetDueBefore", now).Return([]*domain.Reminder{reminder}, nil)
+	jobs.On("GetJobById", reminder.JobId.String()).Return(job, nil)
+	notifier.On("Notify", mock.AnythingOfType("domain.Notification")).Return(errors.New("smtp down"))
+
+	err := service.FireDue(now, context.Background())
+
+	assert.NoError(t, err)
+	assert.False(t, reminder.Done)
+	repo.AssertNotCalled(t, "UpdateReminder", reminder)
+}
+
+func TestFireDue_SkipsDeletedJob(t *testing.T) {
+
+	repo, jobs, notifier, service := InitReminderTest()
+
+	now := time.Now()
+	reminder := domain.NewReminder(domain.NewJob("A", "X", "desc", 1, false, "").Id, now, "Follow up", domain.ReminderRecurrenceNone)
+
+	repo.On("GetDueBefore", now).Return([]*domain.Reminder{reminder}, nil)
+	jobs.On("GetJobById", reminder.JobId.String()).Return(nil, domain.ErrJobNotFound)
+
+	err := service.FireDue(now, context.Background())
+
+	assert.NoError(t, err)
+	assert.False(t, reminder.Done)
+	notifier.AssertNotCalled(t, "Notify", mock.Anything)
+}
+
+func TestGetReminders_InvalidDue(t *testing.T) {
+
+	_, _, _, service := InitReminderTest()
+
+	reminders, err := service.GetReminders("tomorrow", context.Background())
+
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+	assert.Nil(t, reminders)
+}
+
+func TestHandle_StatusChangedSchedulesFollowUp(t *testing.T) {
+
+	repo, _, _, service := InitReminderTest()
+
+	job := domain.NewJob("Google", "Backend", "Go dev", 100000, true, "")
+	job.ChangeStatus(domain.JobStatusApplied)
+	event := domain.NewEvent(domain.JobStatusChanged, job.Id, job)
+
+	repo.On("CompleteRuleReminders", job.Id.String(), event.Id).Return(nil)
+	repo.On("CreateReminder", mock.MatchedBy(func(r *domain.Reminder) bool {
+		return r.JobId == job.Id && *r.EventId == event.Id && r.DueAt.After(time.Now().Add(6*24*time.Hour))
+	})).Return(nil)
+
+	err := service.Handle(context.Background(), event)
+
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestHandle_ArchivedCompletesRuleReminders(t *testing.T) {
+
+	repo, _, _, service := InitReminderTest()
+
+	job := domain.NewJob("Google", "Backend", "Go dev", 100000, true, "")
+	job.Archive(domain.FieldSourceUser)
+	event := domain.NewEvent(domain.JobArchived, job.Id, job)
+
+	repo.On("CompleteRuleReminders", job.Id.String(), uuid.Nil).Return(nil)
+
+	err := service.Handle(context.Background(), event)
+
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "CreateReminder", mock.Anything)
+}
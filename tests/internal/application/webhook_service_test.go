@@ -0,0 +1,101 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"job-tracker/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func InitWebhookTest() (*mocks.WebhookRepositoryMock, *mocks.WebhookClientMock, *application.WebhookService) {
+	var repo = new(mocks.WebhookRepositoryMock)
+	var client = new(mocks.WebhookClientMock)
+	return repo, client, application.NewWebhookService(repo, client, &mocks.LoggerMock{})
+}
+
+func TestHandle_FiltersSubscriptions(t *testing.T) {
+
+	repo, _, service := InitWebhookTest()
+
+	all := domain.NewWebhookSubscription("http://all", "0123456789abcdef", nil)
+	deletes := domain.NewWebhookSubscription("http://deletes", "0123456789abcdef", []domain.EventType{domain.JobDeleted})
+	job := domain.NewJob("Google", "Backend", "Go dev", 100000, true, "")
+
+	repo.On("GetSubscriptions").Return([]*domain.WebhookSubscription{all, deletes}, nil)
+	repo.On("CreateDelivery", mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+		return d.SubscriptionId == all.Id && d.EventType == domain.JobCreated && d.Status == domain.WebhookDeliveryPending
+	})).Return(nil)
+
+	err := service.Handle(context.Background(), domain.NewEvent(domain.JobCreated, job.Id, job))
+
+	assert.NoError(t, err)
+	repo.AssertNumberOfCalls(t, "CreateDelivery", 1)
+}
+
+func TestDispatchDue_RetriesWithBackoffThenDeadLetters(t *testing.T) {
+
+	repo, client, service := InitWebhookTest()
+
+	now := time.Now()
+	subscription := domain.NewWebhookSubscription("http://hook", "0123456789abcdef", nil)
+	delivery := domain.NewWebhookDelivery(subscription.Id, domain.NewEvent(domain.JobDeleted, subscription.Id, nil), "{}")
+
+	repo.On("GetDueDeliveries", mock.Anything, mock.Anything).Return([]*domain.WebhookDelivery{delivery}, nil)
+	repo.On("GetSubscriptionById", subscription.Id.String()).Return(subscription, nil)
+	repo.On("UpdateDelivery", delivery).Return(nil)
+	client.On("Post", subscription, delivery).Return(500, nil)
+
+	assert.NoError(t, service.DispatchDue(now, context.Background()))
+	assert.Equal(t, domain.WebhookDeliveryRetrying, delivery.Status)
+	assert.Equal(t, now.Add(30*time.Second), delivery.NextAttemptAt)
+
+	assert.NoError(t, service.DispatchDue(now, context.Background()))
+	assert.Equal(t, now.Add(60*time.Second), delivery.NextAttemptAt)
+
+	for delivery.Status != domain.WebhookDeliveryDead {
+		assert.NoError(t, service.DispatchDue(now, context.Background()))
+	}
+	assert.Equal(t, 6, delivery.Attempts)
+	assert.Equal(t, 500, delivery.ResponseStatus)
+}
+
+func TestDispatchDue_Success(t *testing.T) {
+
+	repo, client, service := InitWebhookTest()
+
+	subscription := domain.NewWebhookSubscription("http://hook", "0123456789abcdef", nil)
+	delivery := domain.NewWebhookDelivery(subscription.Id, domain.NewEvent(domain.JobDeleted, subscription.Id, nil), "{}")
+
+	repo.On("GetDueDeliveries", mock.Anything, mock.Anything).Return([]*domain.WebhookDelivery{delivery}, nil)
+	repo.On("GetSubscriptionById", subscription.Id.String()).Return(subscription, nil)
+	repo.On("UpdateDelivery", delivery).Return(nil)
+	client.On("Post", subscription, delivery).Return(200, nil)
+
+	assert.NoError(t, service.DispatchDue(time.Now(), context.Background()))
+	assert.Equal(t, domain.WebhookDeliveryDelivered, delivery.Status)
+	assert.NotNil(t, delivery.DeliveredAt)
+}
+
+func TestReplayDelivery(t *testing.T) {
+
+	repo, _, service := InitWebhookTest()
+
+	subscription := domain.NewWebhookSubscription("http://hook", "0123456789abcdef", nil)
+	delivery := domain.NewWebhookDelivery(subscription.Id, domain.NewEvent(domain.JobDeleted, subscription.Id, nil), "{}")
+	delivery.Failed(0, errors.New("timeout"), time.Now(), 1, time.Second)
+
+	repo.On("GetDeliveryById", delivery.Id.String()).Return(delivery, nil)
+	repo.On("UpdateDelivery", delivery).Return(nil)
+
+	replayed, err := service.ReplayDelivery(subscription.Id.String(), delivery.Id.String(), context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.WebhookDeliveryPending, replayed.Status)
+	assert.Equal(t, 0, replayed.Attempts)
+}
@@ -0,0 +1,139 @@
+package bootstrap
+
+import (
+	"bytes"
+	"errors"
+	"job-tracker/internal/bootstrap"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+)
+
+// inTempDir runs the test from an empty directory, so no .env or config file
+// from the repository is picked up.
+func inTempDir(t *testing.T) string {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	t.Setenv("DB_USER", "root")
+	t.Setenv("DB_NAME", "jobtracker")
+	return dir
+}
+
+func writeFile(t *testing.T, path string, content string) {
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func parseFlags(t *testing.T, args ...string) *pflag.FlagSet {
+	flags := pflag.NewFlagSet("api", pflag.ContinueOnError)
+	bootstrap.BindConfigFlags(flags)
+	assert.NoError(t, flags.Parse(args))
+	return flags
+}
+
+func TestLoadConfig_Defaults(t *testing.T) {
+	inTempDir(t)
+
+	cfg, err := bootstrap.LoadConfig(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 8080, cfg.Port)
+	assert.Equal(t, bootstrap.DBDriverPostgres, cfg.DBDriver)
+	assert.Equal(t, "http://localhost:4318", cfg.OTLPEndpoint)
+	assert.Equal(t, 30*time.Second, cfg.ScrapeInterval)
+	assert.Equal(t, 20, cfg.ScrapeConcurrency)
+}
+
+func TestLoadConfig_Layers(t *testing.T) {
+	dir := inTempDir(t)
+	writeFile(t, filepath.Join(dir, "job-tracker.yaml"), `
+port: 7000
+grpc:
+  port: 7001
+db:
+  host: file-host
+  port: 6000
+cache:
+  ttl: 1m
+scrape:
+  interval: 10s
+`)
+	writeFile(t, filepath.Join(dir, ".env"), "DB_HOST=dotenv-host\nDB_PORT=6100\nCACHE_SIZE=50\n")
+	t.Cleanup(func() {
+		for _, key := range []string{"DB_HOST", "CACHE_SIZE"} {
+			_ = os.Unsetenv(key)
+		}
+	})
+	t.Setenv("DB_PORT", "6200")
+
+	cfg, err := bootstrap.LoadConfig(parseFlags(t, "--grpc-port", "7100"))
+	assert.NoError(t, err)
+	assert.Equal(t, 7000, cfg.Port, "file over default")
+	assert.Equal(t, 7100, cfg.GRPCPort, "flag over file")
+	assert.Equal(t, "dotenv-host", cfg.DBHost, ".env over file")
+	assert.Equal(t, 6200, cfg.DBPort, "environment over .env")
+	assert.Equal(t, 50, cfg.CacheSize)
+	assert.Equal(t, time.Minute, cfg.CacheTTL)
+	assert.Equal(t, 10*time.Second, cfg.ScrapeInterval)
+}
+
+func TestLoadConfig_ExplicitTOMLFile(t *testing.T) {
+	dir := inTempDir(t)
+	file := filepath.Join(dir, "settings.toml")
+	writeFile(t, file, "[db]\ndriver = \"sqlite\"\npath = \"jobs.db\"\n")
+
+	cfg, err := bootstrap.LoadConfig(parseFlags(t, "--config", file))
+	assert.NoError(t, err)
+	assert.Equal(t, bootstrap.DBDriverSQLite, cfg.DBDriver)
+	assert.Equal(t, "jobs.db", cfg.DBPath)
+
+	t.Setenv(bootstrap.ConfigFileEnv, filepath.Join(dir, "missing.yaml"))
+	_, err = bootstrap.LoadConfig(nil)
+	assert.Error(t, err)
+}
+
+func TestLoadConfig_ReportsEveryProblem(t *testing.T) {
+	inTempDir(t)
+	t.Setenv("DB_USER", "")
+	t.Setenv("PORT", "70000")
+	t.Setenv("CACHE_BACKEND", "memcached")
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "localhost:4318")
+
+	_, err := bootstrap.LoadConfig(parseFlags(t, "--scrape-concurrency", "0"))
+	var configErr *bootstrap.ConfigError
+	assert.True(t, errors.As(err, &configErr))
+	assert.ElementsMatch(t, []string{
+		"port (PORT): must be between 1 and 65535, got 70000",
+		"db.user (DB_USER): is required with the postgres driver",
+		`cache.backend (CACHE_BACKEND): must be one of memory, redis, none, got "memcached"`,
+		`otel.endpoint (OTEL_EXPORTER_OTLP_ENDPOINT): must be an http(s) URL, got "localhost:4318"`,
+		"scrape.concurrency (SCRAPE_CONCURRENCY): must be positive",
+	}, configErr.Problems)
+}
+
+func TestConfigPrint_RedactsSecrets(t *testing.T) {
+	inTempDir(t)
+	t.Setenv("SMTP_PASSWORD", "smtp-secret")
+
+	root := &cobra.Command{Use: "api", SilenceUsage: true, SilenceErrors: true}
+	bootstrap.BindConfigFlags(root.PersistentFlags())
+	root.AddCommand(bootstrap.NewConfigCommand())
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"config", "print", "--db-password", "db-secret", "--port", "9000"})
+
+	assert.NoError(t, root.Execute())
+	assert.Contains(t, out.String(), "port: 9000")
+	assert.Contains(t, out.String(), "password: '[redacted]'")
+	assert.Contains(t, out.String(), "ttl: 30s")
+	assert.NotContains(t, out.String(), "db-secret")
+	assert.NotContains(t, out.String(), "smtp-secret")
+
+	out.Reset()
+	root.SetArgs([]string{"config", "print", "--db-driver", "mysql"})
+	assert.Error(t, root.Execute())
+	assert.Contains(t, out.String(), "driver: mysql")
+}
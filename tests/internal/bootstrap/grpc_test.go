@@ -0,0 +1,63 @@
+package bootstrap
+
+import (
+	"context"
+	jobtrackerv1 "job-tracker/api/jobtracker/v1"
+	"job-tracker/internal/application"
+	"job-tracker/internal/bootstrap"
+	"job-tracker/internal/infrastructure"
+	"job-tracker/tests/mocks"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+func TestStopGrpcServer_EndsWatchStreams(t *testing.T) {
+	db, err := infrastructure.OpenSQLite(filepath.Join(t.TempDir(), "jobs.db"), &gorm.Config{})
+	assert.NoError(t, err)
+	migrator, err := infrastructure.NewMigrator(db)
+	assert.NoError(t, err)
+	assert.NoError(t, migrator.Up(context.Background()))
+
+	service := application.NewJobService(infrastructure.NewJobRepository(db), &mocks.LoggerMock{}, noop.NewTracerProvider())
+	jobs := infrastructure.NewJobGrpcServer(service, application.NewEventStream(infrastructure.NewOutboxRepository(db), &mocks.LoggerMock{}), &mocks.LoggerMock{})
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	jobs.Register(server)
+	go func() { _ = server.Serve(listener) }()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	defer conn.Close()
+	client := jobtrackerv1.NewJobTrackerClient(conn)
+	_, err = client.CreateJob(context.Background(), &jobtrackerv1.CreateJobRequest{Company: "Google", Position: "Backend", Description: "Go dev"})
+	assert.NoError(t, err)
+	stream, err := client.WatchJobs(context.Background(), &jobtrackerv1.WatchJobsRequest{AfterSequence: proto.Int64(0)})
+	assert.NoError(t, err)
+	// Receiving the replayed event proves the stream is open on the server.
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	started := time.Now()
+	bootstrap.StopGrpcServer(ctx, server, jobs)
+
+	assert.Less(t, time.Since(started), time.Second)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
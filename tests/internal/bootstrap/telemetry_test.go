@@ -0,0 +1,113 @@
+package bootstrap
+
+import (
+	"context"
+	"encoding/json"
+	"job-tracker/internal/bootstrap"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func telemetryConfig(t *testing.T, args ...string) *bootstrap.Config {
+	inTempDir(t)
+	cfg, err := bootstrap.LoadConfig(parseFlags(t, append([]string{"--pyroscope-enabled=false"}, args...)...))
+	assert.NoError(t, err)
+	return cfg
+}
+
+func shutdown(t *testing.T, telemetry *bootstrap.Telemetry) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_ = telemetry.Shutdown(ctx)
+}
+
+func health(t *testing.T, telemetry *bootstrap.Telemetry) (map[string]any, *gin.Engine) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	bootstrap.RegisterStatus(r, telemetry)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var body map[string]any
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	return body, r
+}
+
+func TestInitTelemetry_AllSignalsOff(t *testing.T) {
+	cfg := telemetryConfig(t, "--otel-logs-exporter", "none", "--otel-traces-exporter", "none", "--otel-metrics-exporter", "none")
+
+	telemetry := bootstrap.InitTelemetry(cfg)
+	defer shutdown(t, telemetry)
+	assert.NotNil(t, telemetry.Logger)
+	_, span := telemetry.TracerProvider.Tracer("test").Start(context.Background(), "span")
+	assert.False(t, span.SpanContext().IsValid())
+	assert.Empty(t, telemetry.Degraded)
+	assert.Nil(t, telemetry.MetricsHandler)
+
+	body, _ := health(t, telemetry)
+	assert.Equal(t, map[string]any{"status": "ok"}, body)
+}
+
+func TestInitTelemetry_UnreachableCollectorDegrades(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	endpoint := "http://" + listener.Addr().String()
+	assert.NoError(t, listener.Close())
+	cfg := telemetryConfig(t, "--otel-endpoint", endpoint, "--otel-metrics-exporter", "prometheus")
+
+	telemetry := bootstrap.InitTelemetry(cfg)
+	defer shutdown(t, telemetry)
+	assert.NotNil(t, telemetry.Logger)
+	assert.Equal(t, []string{bootstrap.SignalLogs, bootstrap.SignalTraces}, telemetry.Degraded)
+
+	body, _ := health(t, telemetry)
+	assert.Equal(t, "ok", body["status"])
+	assert.Equal(t, []any{"logs", "traces"}, body["degraded"])
+}
+
+func TestInitTelemetry_UnreachablePyroscopeDegrades(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	address := "http://" + listener.Addr().String()
+	assert.NoError(t, listener.Close())
+	cfg := telemetryConfig(t, "--otel-logs-exporter", "none", "--otel-traces-exporter", "none", "--otel-metrics-exporter", "none",
+		"--pyroscope-enabled=true", "--pyroscope-address", address)
+
+	telemetry := bootstrap.InitTelemetry(cfg)
+	defer shutdown(t, telemetry)
+	assert.Equal(t, []string{bootstrap.SignalProfiles}, telemetry.Degraded)
+
+	body, _ := health(t, telemetry)
+	assert.Equal(t, []any{"profiles"}, body["degraded"])
+}
+
+func TestInitTelemetry_PrometheusEndpoint(t *testing.T) {
+	cfg := telemetryConfig(t, "--otel-logs-exporter", "none", "--otel-traces-exporter", "stdout", "--otel-metrics-exporter", "prometheus")
+
+	telemetry := bootstrap.InitTelemetry(cfg)
+	defer shutdown(t, telemetry)
+	assert.Empty(t, telemetry.Degraded)
+
+	counter, err := telemetry.MeterProvider.Meter("test").Int64Counter("test.requests")
+	assert.NoError(t, err)
+	counter.Add(context.Background(), 3)
+
+	_, r := health(t, telemetry)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "test_requests_total")
+}
+
+func TestLoadConfig_RejectsSampleRatio(t *testing.T) {
+	inTempDir(t)
+	_, err := bootstrap.LoadConfig(parseFlags(t, "--otel-traces-sample-ratio", "1.5", "--otel-metrics-exporter", "statsd"))
+	assert.ErrorContains(t, err, "otel.traces.sample_ratio (OTEL_TRACES_SAMPLER_ARG): must be between 0 and 1, got 1.5")
+	assert.ErrorContains(t, err, `otel.metrics.exporter (OTEL_METRICS_EXPORTER): must be one of otlp, prometheus, stdout, none, got "statsd"`)
+}
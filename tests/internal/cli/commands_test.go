@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"job-tracker/internal/application"
+	"job-tracker/internal/cli"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"job-tracker/tests/mocks"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace/noop"
+	"gorm.io/gorm"
+)
+
+func run(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	var out bytes.Buffer
+	cmd := cli.NewRootCommand()
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestJobctl_LocalLifecycle(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	db := filepath.Join(t.TempDir(), "jobs.db")
+
+	out, err := run(t, "--local", "--db", db, "-o", "json", "add", "--company", "Google", "--position", "Backend", "--description", "Go dev", "--salary", "100")
+	assert.NoError(t, err)
+	var job domain.Job
+	assert.NoError(t, json.Unmarshal([]byte(out), &job))
+	assert.Equal(t, domain.JobStatusPending, job.Status)
+
+	_, err = run(t, "--local", "--db", db, "add", "--company", "G", "--position", "Backend", "--description", "Go dev")
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+
+	_, err = run(t, "--local", "--db", db, "set-status", job.Id.String(), "applied")
+	assert.NoError(t, err)
+
+	out, err = run(t, "--local", "--db", db, "ls", "--status", "APPLIED")
+	assert.NoError(t, err)
+	assert.Contains(t, out, job.Id.String())
+	assert.Contains(t, out, "APPLIED")
+
+	out, err = run(t, "--local", "--db", db, "-o", "yaml", "show", job.Id.String())
+	assert.NoError(t, err)
+	assert.Contains(t, out, "company: Google\n")
+	assert.Contains(t, out, "status: APPLIED\n")
+
+	_, err = run(t, "--local", "--db", db, "set-status", job.Id.String(), "hired")
+	assert.ErrorContains(t, err, "unknown status")
+
+	out, err = run(t, "--local", "--db", db, "rm", job.Id.String())
+	assert.NoError(t, err)
+	assert.Equal(t, "deleted "+job.Id.String()+"\n", out)
+
+	_, err = run(t, "--local", "--db", db, "show", job.Id.String())
+	assert.ErrorIs(t, err, domain.ErrJobNotFound)
+}
+
+func TestJobctl_RemoteUsesTokenAndConfig(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, db.AutoMigrate(&domain.Job{}, &domain.OutboxMessage{}, &domain.OutboxCheckpoint{}))
+	service := application.NewJobService(infrastructure.NewJobRepository(db), &mocks.LoggerMock{}, noop.NewTracerProvider())
+
+	var tokens []string
+	r := gin.New()
+	r.Use(func(c *gin.Context) { tokens = append(tokens, c.GetHeader("Authorization")) })
+	infrastructure.NewJobHandler(service, &mocks.LoggerMock{}).RegisterRoutes(r.Group("/api/v1"))
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config := filepath.Join(t.TempDir(), "jobctl.yaml")
+	assert.NoError(t, os.WriteFile(config, []byte("server: "+server.URL+"/api/v1\ntoken: secret\n"), 0o600))
+
+	out, err := run(t, "--config", config, "-o", "json", "add", "--company", "Google", "--position", "Backend", "--description", "Go dev")
+	assert.NoError(t, err)
+	var job domain.Job
+	assert.NoError(t, json.Unmarshal([]byte(out), &job))
+
+	out, err = run(t, "--config", config, "export")
+	assert.NoError(t, err)
+	var exported []domain.Job
+	assert.NoError(t, json.Unmarshal([]byte(out), &exported))
+	assert.Len(t, exported, 1)
+
+	t.Setenv("JOBCTL_TOKEN", "from-env")
+	_, err = run(t, "--config", config, "show", "00000000-0000-4000-8000-000000000000")
+	assert.ErrorIs(t, err, domain.ErrJobNotFound)
+
+	assert.Equal(t, []string{"Bearer secret", "Bearer secret", "Bearer from-env"}, tokens)
+}
+
+func TestJobctl_ImportReadsPosting(t *testing.T) {
+	posting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html><head>
+			<title>Ignored</title>
+			<meta property="og:site_name" content="Acme">
+			<meta property="og:title" content="Staff Engineer">
+			<meta name="description" content="Build things">
+		</head></html>`))
+	}))
+	defer posting.Close()
+
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	db := filepath.Join(t.TempDir(), "jobs.db")
+
+	out, err := run(t, "--local", "--db", db, "-o", "json", "import", posting.URL+"/jobs/1", "--salary", "200")
+	assert.NoError(t, err)
+	var job domain.Job
+	assert.NoError(t, json.Unmarshal([]byte(out), &job))
+	assert.Equal(t, "Acme", job.Company)
+	assert.Equal(t, "Staff Engineer", job.Position)
+	assert.Equal(t, "Build things", job.Description)
+	assert.Equal(t, 200, job.Salary)
+	assert.True(t, strings.HasSuffix(job.Url, "/jobs/1"))
+}
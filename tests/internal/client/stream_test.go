@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"job-tracker/internal/application"
+	"job-tracker/internal/client"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"job-tracker/tests/mocks"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace/noop"
+	"gorm.io/gorm"
+)
+
+func TestRESTBackend_WatchFollowsEventStream(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, db.AutoMigrate(&domain.Job{}, &domain.OutboxMessage{}, &domain.OutboxCheckpoint{}))
+	outbox := infrastructure.NewOutboxRepository(db)
+	stream := application.NewEventStream(outbox, &mocks.LoggerMock{})
+	service := application.NewJobService(infrastructure.NewJobRepository(db), &mocks.LoggerMock{}, noop.NewTracerProvider())
+
+	r := gin.New()
+	v1 := r.Group("/api/v1")
+	infrastructure.NewJobHandler(service, &mocks.LoggerMock{}).RegisterRoutes(v1)
+	infrastructure.NewEventStreamHandler(stream, &mocks.LoggerMock{}).RegisterRoutes(v1)
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	backend := client.NewRESTBackend(server.URL+"/api/v1", "")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	events, err := backend.Watch(ctx)
+	assert.NoError(t, err)
+
+	job, err := backend.CreateJob(&application.CreateJobRequest{Company: "Google", Position: "Backend", Description: "Go dev"}, ctx)
+	assert.NoError(t, err)
+	_, err = backend.UpdateNotes(job.Id, "Ask about the team", job.Version, ctx)
+	assert.NoError(t, err)
+
+	published, err := outbox.GetEventsAfter(0, 10, ctx)
+	assert.NoError(t, err)
+	for _, event := range published {
+		assert.NoError(t, stream.Handle(ctx, event))
+	}
+
+	created := <-events
+	assert.Equal(t, domain.JobCreated, created.Type)
+	updated := <-events
+	assert.Equal(t, domain.JobUpdated, updated.Type)
+	assert.Equal(t, "Ask about the team", updated.Job.Notes)
+	assert.Equal(t, int64(2), updated.Sequence)
+
+	_, err = backend.UpdateNotes(job.Id, "stale", job.Version, ctx)
+	assert.ErrorIs(t, err, domain.ErrVersionConflict)
+}
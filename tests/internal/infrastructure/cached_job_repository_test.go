@@ -0,0 +1,196 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"job-tracker/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/trace/noop"
+	"gorm.io/gorm"
+)
+
+type cacheFixture struct {
+	db     *gorm.DB
+	repo   domain.JobRepository
+	reader *sdkmetric.ManualReader
+}
+
+func setupCachedRepository(t *testing.T, cache infrastructure.JobCache) *cacheFixture {
+	db := setupTestDB(t)
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	repo := infrastructure.NewCachedJobRepository(infrastructure.NewJobRepository(db), cache, &mocks.LoggerMock{}, provider)
+	return &cacheFixture{db: db, repo: repo, reader: reader}
+}
+
+// counter sums every data point of the named counter.
+func (f *cacheFixture) counter(t *testing.T, name string) int64 {
+	var rm metricdata.ResourceMetrics
+	assert.NoError(t, f.reader.Collect(context.Background(), &rm))
+	var total int64
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if sum, ok := m.Data.(metricdata.Sum[int64]); ok && m.Name == name {
+				for _, point := range sum.DataPoints {
+					total += point.Value
+				}
+			}
+		}
+	}
+	return total
+}
+
+// touch changes a job behind the repository's back, leaving the cache stale.
+func (f *cacheFixture) touch(t *testing.T, job *domain.Job, company string) {
+	assert.NoError(t, f.db.Model(&domain.Job{}).Where("id = ?", job.Id).Update("company", company).Error)
+}
+
+func jobCaches(t *testing.T) map[string]func() infrastructure.JobCache {
+	return map[string]func() infrastructure.JobCache{
+		"memory": func() infrastructure.JobCache {
+			return infrastructure.NewMemoryJobCache(100, time.Minute)
+		},
+		"redis": func() infrastructure.JobCache {
+			client := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+			return infrastructure.NewRedisJobCache(client, time.Minute)
+		},
+	}
+}
+
+func TestCachedJobRepository_ReadThrough(t *testing.T) {
+	for name, newCache := range jobCaches(t) {
+		t.Run(name, func(t *testing.T) {
+			f := setupCachedRepository(t, newCache())
+			ctx := context.Background()
+			job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+			assert.NoError(t, f.repo.CreateJob(job, ctx))
+
+			first, err := f.repo.GetJobById(job.Id.String(), ctx)
+			assert.NoError(t, err)
+			f.touch(t, job, "Meta")
+			second, err := f.repo.GetJobById(job.Id.String(), ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, "Google", second.Company)
+			assert.Equal(t, first.Version, second.Version)
+
+			fresh, err := f.repo.GetJobById(job.Id.String(), infrastructure.WithoutCache(ctx))
+			assert.NoError(t, err)
+			assert.Equal(t, "Meta", fresh.Company)
+
+			assert.Equal(t, int64(1), f.counter(t, "job_tracker.cache.hits"))
+			assert.Equal(t, int64(2), f.counter(t, "job_tracker.cache.misses"))
+		})
+	}
+}
+
+func TestCachedJobRepository_WritesInvalidate(t *testing.T) {
+	for name, newCache := range jobCaches(t) {
+		t.Run(name, func(t *testing.T) {
+			f := setupCachedRepository(t, newCache())
+			service := application.NewJobService(f.repo, &mocks.LoggerMock{}, noop.NewTracerProvider())
+			ctx := context.Background()
+			job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+			assert.NoError(t, f.repo.CreateJob(job, ctx))
+
+			jobs, err := f.repo.GetAll(false, ctx)
+			assert.NoError(t, err)
+			assert.Len(t, jobs, 1)
+			applied, err := f.repo.GetJobsByStatus(domain.JobStatusApplied, false, ctx)
+			assert.NoError(t, err)
+			assert.Empty(t, applied)
+
+			updated, err := service.UpdateJobStatus(job.Id, &application.UpdateJobStatusRequest{Status: "APPLIED", Version: 1}, ctx)
+			assert.NoError(t, err)
+			assert.NoError(t, f.repo.CreateJob(domain.NewJob("Amazon", "Java", "Spring", 200, false, ""), ctx))
+
+			found, err := f.repo.GetJobById(job.Id.String(), ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, updated.Version, found.Version)
+			jobs, err = f.repo.GetAll(false, ctx)
+			assert.NoError(t, err)
+			assert.Len(t, jobs, 2)
+			applied, err = f.repo.GetJobsByStatus(domain.JobStatusApplied, false, ctx)
+			assert.NoError(t, err)
+			assert.Len(t, applied, 1)
+
+			assert.NoError(t, f.repo.DeleteJob(job.Id.String(), ctx))
+			_, err = f.repo.GetJobById(job.Id.String(), ctx)
+			assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		})
+	}
+}
+
+func TestCachedJobRepository_TransactionInvalidatesAfterCommit(t *testing.T) {
+	f := setupCachedRepository(t, infrastructure.NewMemoryJobCache(100, time.Minute))
+	ctx := context.Background()
+	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	assert.NoError(t, f.repo.CreateJob(job, ctx))
+	_, err := f.repo.GetJobById(job.Id.String(), ctx)
+	assert.NoError(t, err)
+
+	rollback := errors.New("rollback")
+	err = f.repo.Transaction(func(repository domain.JobRepository) error {
+		inside, err := repository.GetJobById(job.Id.String(), ctx)
+		assert.NoError(t, err)
+		inside.ChangeStatus(domain.JobStatusOffer)
+		assert.NoError(t, repository.UpdateJob(inside, ctx))
+		return rollback
+	}, ctx)
+	assert.ErrorIs(t, err, rollback)
+
+	err = f.repo.Transaction(func(repository domain.JobRepository) error {
+		inside, err := repository.GetJobById(job.Id.String(), ctx)
+		assert.NoError(t, err)
+		inside.ChangeStatus(domain.JobStatusApplied)
+		return repository.UpdateJob(inside, ctx)
+	}, ctx)
+	assert.NoError(t, err)
+
+	found, err := f.repo.GetJobById(job.Id.String(), ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.JobStatusApplied, found.Status)
+	assert.Equal(t, 2, found.Version)
+}
+
+func TestCachedJobRepository_EntriesExpire(t *testing.T) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	f := setupCachedRepository(t, infrastructure.NewRedisJobCache(client, time.Minute))
+	ctx := context.Background()
+	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	assert.NoError(t, f.repo.CreateJob(job, ctx))
+	_, err := f.repo.GetJobById(job.Id.String(), ctx)
+	assert.NoError(t, err)
+
+	f.touch(t, job, "Meta")
+	server.FastForward(2 * time.Minute)
+
+	found, err := f.repo.GetJobById(job.Id.String(), ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "Meta", found.Company)
+}
+
+func TestCachedJobRepository_UnavailableCacheFallsBack(t *testing.T) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr(), MaxRetries: -1})
+	f := setupCachedRepository(t, infrastructure.NewRedisJobCache(client, time.Minute))
+	ctx := context.Background()
+	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	server.Close()
+
+	assert.NoError(t, f.repo.CreateJob(job, ctx))
+	found, err := f.repo.GetJobById(job.Id.String(), ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "Google", found.Company)
+	assert.Equal(t, int64(1), f.counter(t, "job_tracker.cache.misses"))
+}
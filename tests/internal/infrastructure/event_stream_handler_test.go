@@ -0,0 +1,189 @@
+package infrastructure
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"job-tracker/tests/mocks"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func readSSEIds(t *testing.T, reader *bufio.Reader, count int) []string {
+	var ids []string
+	for len(ids) < count {
+		line, err := reader.ReadString('\n')
+		assert.NoError(t, err)
+		if strings.HasPrefix(line, "id:") {
+			ids = append(ids, strings.TrimSpace(strings.TrimPrefix(line, "id:")))
+		}
+	}
+	return ids
+}
+
+func TestEventStream_ResumesAndFilters(t *testing.T) {
+	db := setupTestDB(t)
+	repo := infrastructure.NewJobRepository(db)
+	outbox := infrastructure.NewOutboxRepository(db)
+	stream := application.NewEventStream(outbox, &mocks.LoggerMock{})
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	infrastructure.NewEventStreamHandler(stream, &mocks.LoggerMock{}).RegisterRoutes(r)
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	assert.NoError(t, repo.CreateJob(job, context.Background()))
+	job.ChangeStatus(domain.JobStatusApplied)
+	assert.NoError(t, repo.UpdateJob(job, context.Background()))
+	assert.NoError(t, repo.CreateJob(domain.NewJob("Amazon", "Java", "Spring", 200, false, ""), context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/events/stream?types=job.created", nil)
+	req.Header.Set("Last-Event-ID", "1")
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Contains(t, resp.Header.Get("Content-Type"), "text/event-stream")
+
+	reader := bufio.NewReader(resp.Body)
+	assert.Equal(t, []string{"3"}, readSSEIds(t, reader, 1))
+
+	live := domain.NewJob("Meta", "Go", "Go", 300, true, "")
+	assert.NoError(t, repo.CreateJob(live, context.Background()))
+	events, err := outbox.GetEventsAfter(3, 10, ctx)
+	assert.NoError(t, err)
+	for _, event := range events {
+		assert.NoError(t, stream.Handle(context.Background(), event))
+	}
+
+	assert.Equal(t, []string{"4"}, readSSEIds(t, reader, 1))
+}
+
+func TestEventStream_DropsSlowListener(t *testing.T) {
+	db := setupTestDB(t)
+	repo := infrastructure.NewJobRepository(db)
+	stream := application.NewEventStream(infrastructure.NewOutboxRepository(db), &mocks.LoggerMock{})
+	slow, _ := stream.Subscribe()
+	fast, unsubscribe := stream.Subscribe()
+	defer unsubscribe()
+
+	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	assert.NoError(t, repo.CreateJob(job, context.Background()))
+	event := domain.NewEvent(domain.JobUpdated, job.Id, job)
+	for sequence := range int64(100) {
+		event.Sequence = sequence + 1
+		assert.NoError(t, stream.Handle(context.Background(), event))
+		<-fast
+	}
+
+	received := 0
+	for range slow {
+		received++
+	}
+	assert.Equal(t, 64, received)
+}
+
+func TestEventStream_SlowClientMissesNothing(t *testing.T) {
+	db := setupTestDB(t)
+	repo := infrastructure.NewJobRepository(db)
+	outbox := infrastructure.NewOutboxRepository(db)
+	stream := application.NewEventStream(outbox, &mocks.LoggerMock{})
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	infrastructure.NewEventStreamHandler(stream, &mocks.LoggerMock{}).RegisterRoutes(r)
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/events/stream", nil)
+	req.Header.Set("Last-Event-ID", "0")
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	// Publish far more than a listener buffers before reading anything, so the
+	// handler may be dropped and has to catch up from the outbox.
+	const total = 300
+	for range total {
+		assert.NoError(t, repo.CreateJob(domain.NewJob("Google", "Backend", "Go", 100, true, ""), context.Background()))
+	}
+	events, err := outbox.GetEventsAfter(0, total, ctx)
+	assert.NoError(t, err)
+	for _, event := range events {
+		assert.NoError(t, stream.Handle(context.Background(), event))
+	}
+
+	ids := readSSEIds(t, bufio.NewReader(resp.Body), total)
+	for i, id := range ids {
+		assert.Equal(t, strconv.Itoa(i+1), id)
+	}
+}
+
+func TestEventStream_FiltersByUser(t *testing.T) {
+	db := setupTestDB(t)
+	repo := infrastructure.NewJobRepository(db)
+	stream := application.NewEventStream(infrastructure.NewOutboxRepository(db), &mocks.LoggerMock{})
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	infrastructure.NewEventStreamHandler(stream, &mocks.LoggerMock{}).RegisterRoutes(r)
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	for _, userId := range []string{"bob", "alice", ""} {
+		job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+		job.UserId = userId
+		assert.NoError(t, repo.CreateJob(job, context.Background()))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/events/stream?userId=alice&lastEventId=0", nil)
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, []string{"2"}, readSSEIds(t, bufio.NewReader(resp.Body), 1))
+}
+
+func TestEventStream_ClosedOnShutdown(t *testing.T) {
+	db := setupTestDB(t)
+	stream := application.NewEventStream(infrastructure.NewOutboxRepository(db), &mocks.LoggerMock{})
+	handler := infrastructure.NewEventStreamHandler(stream, &mocks.LoggerMock{})
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	handler.RegisterRoutes(r)
+	server := httptest.NewUnstartedServer(r)
+	server.Config.RegisterOnShutdown(handler.CloseStreams)
+	server.Start()
+
+	resp, err := http.Get(server.URL + "/events/stream")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	started := time.Now()
+	assert.NoError(t, server.Config.Shutdown(ctx))
+	assert.Less(t, time.Since(started), time.Second)
+	// Drops the stream if shutdown left it open, so the read below ends.
+	server.CloseClientConnections()
+	_, err = io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+}
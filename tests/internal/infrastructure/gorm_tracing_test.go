@@ -0,0 +1,87 @@
+package infrastructure
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func spanAttribute(span sdktrace.ReadOnlySpan, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestGormTracing_QueriesAreChildSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	db := setupTestDB(t)
+	assert.NoError(t, db.Use(infrastructure.NewGormTracing(provider)))
+	repo := infrastructure.NewJobRepository(db)
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "request")
+	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	assert.NoError(t, repo.CreateJob(job, ctx))
+	_, err := repo.GetJobById(job.Id.String(), ctx)
+	assert.NoError(t, err)
+	parent.End()
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	for _, name := range []string{"INSERT jobs", "SELECT jobs"} {
+		span, ok := spans[name]
+		if !assert.True(t, ok, name) {
+			continue
+		}
+		assert.Equal(t, parent.SpanContext().TraceID(), span.SpanContext().TraceID())
+		assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+		assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+
+		query, _ := spanAttribute(span, "db.query.text")
+		assert.Contains(t, query.AsString(), "jobs")
+		rows, _ := spanAttribute(span, "db.rows_affected")
+		assert.Equal(t, int64(1), rows.AsInt64())
+		system, _ := spanAttribute(span, "db.system.name")
+		assert.Equal(t, "sqlite", system.AsString())
+	}
+}
+
+func TestGormTracing_EveryRepositoryTracesUnderTheCaller(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	db := setupTestDB(t)
+	assert.NoError(t, db.Use(infrastructure.NewGormTracing(provider)))
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "request")
+	_, err := infrastructure.NewReminderRepository(db).GetPending(ctx)
+	assert.NoError(t, err)
+	_, err = infrastructure.NewNotificationRepository(db).GetChannelsByUser("alice", ctx)
+	assert.NoError(t, err)
+	_, err = infrastructure.NewWebhookRepository(db).GetSubscriptions(ctx)
+	assert.NoError(t, err)
+	_, err = infrastructure.NewOutboxRepository(db).GetLatestSequence(ctx)
+	assert.NoError(t, err)
+	parent.End()
+
+	var queries []string
+	for _, span := range recorder.Ended() {
+		if span.Name() == "request" {
+			continue
+		}
+		queries = append(queries, span.Name())
+		assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID(), span.Name())
+	}
+	assert.Len(t, queries, 4)
+}
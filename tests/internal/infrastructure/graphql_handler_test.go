@@ -0,0 +1,242 @@
+package infrastructure
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"job-tracker/tests/mocks"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace/noop"
+	"gorm.io/gorm"
+)
+
+type countingJobRepository struct {
+	domain.JobRepository
+	batches atomic.Int32
+}
+
+func (r *countingJobRepository) GetJobsByIds(ids []string, ctx context.Context) ([]*domain.Job, error) {
+	r.batches.Add(1)
+	return r.JobRepository.GetJobsByIds(ids, ctx)
+}
+
+type countingReminderRepository struct {
+	domain.ReminderRepository
+	batches atomic.Int32
+}
+
+func (r *countingReminderRepository) GetByJobIds(jobIds []string, ctx context.Context) ([]*domain.Reminder, error) {
+	r.batches.Add(1)
+	return r.ReminderRepository.GetByJobIds(jobIds, ctx)
+}
+
+type graphQLFixture struct {
+	db        *gorm.DB
+	router    *gin.Engine
+	handler   *infrastructure.GraphQLHandler
+	jobs      *countingJobRepository
+	reminders *countingReminderRepository
+	stream    *application.EventStream
+}
+
+func setupGraphQL(t *testing.T) *graphQLFixture {
+	gin.SetMode(gin.TestMode)
+	db := setupTestDB(t)
+	jobs := &countingJobRepository{JobRepository: infrastructure.NewJobRepository(db)}
+	reminders := &countingReminderRepository{ReminderRepository: infrastructure.NewReminderRepository(db)}
+	stream := application.NewEventStream(infrastructure.NewOutboxRepository(db), &mocks.LoggerMock{})
+
+	r := gin.New()
+	handler := infrastructure.NewGraphQLHandler(
+		application.NewJobService(jobs, &mocks.LoggerMock{}, noop.NewTracerProvider()),
+		application.NewReminderService(reminders, jobs, &mocks.NotifierMock{}, &mocks.LoggerMock{}),
+		stream,
+		&mocks.LoggerMock{},
+	)
+	handler.RegisterRoutes(r)
+	return &graphQLFixture{db: db, router: r, handler: handler, jobs: jobs, reminders: reminders, stream: stream}
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func (f *graphQLFixture) exec(t *testing.T, query string, variables map[string]any) graphQLResponse {
+	body, _ := json.Marshal(map[string]any{"query": query, "variables": variables})
+	w := httptest.NewRecorder()
+	f.router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body))))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response graphQLResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	return response
+}
+
+func TestGraphQL_NestedQueryIsBatched(t *testing.T) {
+	f := setupGraphQL(t)
+	companies := []string{"Google", "Amazon", "Meta", "Apple", "Netflix", "Stripe", "Shopify", "Spotify"}
+	for _, company := range companies {
+		job := domain.NewJob(company, "Backend", "Go", 100, true, "")
+		assert.NoError(t, f.jobs.CreateJob(job, context.Background()))
+		reminder := domain.NewReminder(job.Id, time.Now().Add(time.Hour), "Follow up with "+company, domain.ReminderRecurrenceNone)
+		assert.NoError(t, f.reminders.CreateReminder(reminder, context.Background()))
+	}
+
+	response := f.exec(t, `{ reminders { message job { company reminders { message } } } }`, nil)
+	assert.Empty(t, response.Errors)
+
+	var data struct {
+		Reminders []struct {
+			Message string
+			Job     struct {
+				Company   string
+				Reminders []struct{ Message string }
+			}
+		}
+	}
+	assert.NoError(t, json.Unmarshal(response.Data, &data))
+	assert.Len(t, data.Reminders, len(companies))
+	for _, reminder := range data.Reminders {
+		assert.Equal(t, "Follow up with "+reminder.Job.Company, reminder.Message)
+		assert.Len(t, reminder.Job.Reminders, 1)
+	}
+	// Batches follow a wait window, so a slow runner may split a level, but
+	// loads never go one per item.
+	const depth = 3
+	assert.LessOrEqual(t, f.jobs.batches.Load(), int32(depth))
+	assert.LessOrEqual(t, f.reminders.batches.Load(), int32(depth))
+}
+
+func TestGraphQL_Mutations(t *testing.T) {
+	f := setupGraphQL(t)
+
+	created := f.exec(t, `mutation($input: CreateJobInput!) { createJob(input: $input) { id status version } }`,
+		map[string]any{"input": map[string]any{"company": "Google", "position": "Backend", "description": "Go"}})
+	assert.Empty(t, created.Errors)
+	var data struct {
+		CreateJob struct {
+			Id      string
+			Status  string
+			Version int
+		}
+	}
+	assert.NoError(t, json.Unmarshal(created.Data, &data))
+	assert.Equal(t, "PENDING", data.CreateJob.Status)
+
+	updated := f.exec(t, `mutation($id: ID!) { updateJobStatus(id: $id, status: APPLIED, version: 1) { version } }`,
+		map[string]any{"id": data.CreateJob.Id})
+	assert.Empty(t, updated.Errors)
+
+	stale := f.exec(t, `mutation($id: ID!) { updateJobStatus(id: $id, status: OFFER, version: 1) { version } }`,
+		map[string]any{"id": data.CreateJob.Id})
+	assert.Len(t, stale.Errors, 1)
+	assert.Equal(t, "/problems/version-conflict", stale.Errors[0].Extensions["type"])
+
+	deleted := f.exec(t, `mutation($id: ID!) { deleteJob(id: $id) }`, map[string]any{"id": data.CreateJob.Id})
+	assert.Empty(t, deleted.Errors)
+
+	missing := f.exec(t, `query($id: ID!) { job(id: $id) { id } }`, map[string]any{"id": data.CreateJob.Id})
+	assert.Empty(t, missing.Errors)
+	assert.JSONEq(t, `{"job":null}`, string(missing.Data))
+}
+
+func TestGraphQL_SubscriptionOverSSE(t *testing.T) {
+	f := setupGraphQL(t)
+	server := httptest.NewServer(f.router)
+	defer server.Close()
+
+	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	assert.NoError(t, f.jobs.CreateJob(job, context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	query := url.Values{"query": {`subscription($jobId: ID) { jobUpdated(jobId: $jobId) { type job { status } } }`}}
+	query.Set("variables", `{"jobId":"`+job.Id.String()+`"}`)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/graphql?"+query.Encode(), nil)
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Contains(t, resp.Header.Get("Content-Type"), "text/event-stream")
+
+	other := domain.NewJob("Amazon", "Java", "Spring", 200, false, "")
+	assert.NoError(t, f.jobs.CreateJob(other, context.Background()))
+	job.ChangeStatus(domain.JobStatusApplied)
+	assert.NoError(t, f.jobs.UpdateJob(job, context.Background()))
+	events, err := infrastructure.NewOutboxRepository(f.db).GetEventsAfter(1, 10, context.Background())
+	assert.NoError(t, err)
+	for _, event := range events {
+		assert.NoError(t, f.stream.Handle(context.Background(), event))
+	}
+
+	reader := bufio.NewReader(resp.Body)
+	for {
+		line, err := reader.ReadString('\n')
+		assert.NoError(t, err)
+		if data, ok := strings.CutPrefix(line, "data:"); ok {
+			assert.JSONEq(t, `{"data":{"jobUpdated":{"type":"job.status_changed","job":{"status":"APPLIED"}}}}`, data)
+			return
+		}
+	}
+}
+
+func TestGraphQL_GetServesOnlySubscriptions(t *testing.T) {
+	f := setupGraphQL(t)
+	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	assert.NoError(t, f.jobs.CreateJob(job, context.Background()))
+
+	deleteJob := `mutation { deleteJob(id: "` + job.Id.String() + `") }`
+	for _, query := range []url.Values{
+		{"query": {deleteJob}},
+		{"query": {`{ jobs { id } }`}},
+		{"query": {"# subscription { jobUpdated { type } }\n" + deleteJob}},
+		{"query": {`subscription Watch { jobUpdated { type } } ` + strings.Replace(deleteJob, "mutation", "mutation Drop", 1)}, "operationName": {"Drop"}},
+	} {
+		w := httptest.NewRecorder()
+		f.router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/graphql?"+query.Encode(), nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code, query.Get("query"))
+		assert.Equal(t, "POST", w.Header().Get("Allow"))
+	}
+
+	found, err := f.jobs.GetJobById(job.Id.String(), context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, job.Id, found.Id)
+}
+
+func TestGraphQL_SubscriptionClosedOnShutdown(t *testing.T) {
+	f := setupGraphQL(t)
+	server := httptest.NewUnstartedServer(f.router)
+	server.Config.RegisterOnShutdown(f.handler.CloseStreams)
+	server.Start()
+
+	query := url.Values{"query": {`subscription { jobUpdated { type } }`}}
+	resp, err := http.Get(server.URL + "/graphql?" + query.Encode())
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	started := time.Now()
+	assert.NoError(t, server.Config.Shutdown(ctx))
+	assert.Less(t, time.Since(started), time.Second)
+	// Drops the stream if shutdown left it open, so the read below ends.
+	server.CloseClientConnections()
+	_, err = io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,135 @@
+package infrastructure
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	jobtrackerv1 "job-tracker/api/jobtracker/v1"
+	"job-tracker/internal/application"
+	"job-tracker/internal/infrastructure"
+	"job-tracker/tests/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+func setupGrpcClient(t *testing.T) jobtrackerv1.JobTrackerClient {
+	db := setupTestDB(t)
+	service := application.NewJobService(infrastructure.NewJobRepository(db), &mocks.LoggerMock{}, noop.NewTracerProvider())
+	stream := application.NewEventStream(infrastructure.NewOutboxRepository(db), &mocks.LoggerMock{})
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	infrastructure.NewJobGrpcServer(service, stream, &mocks.LoggerMock{}).Register(server)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return jobtrackerv1.NewJobTrackerClient(conn)
+}
+
+func TestGrpc_JobLifecycle(t *testing.T) {
+	client := setupGrpcClient(t)
+	ctx := context.Background()
+
+	created, err := client.CreateJob(ctx, &jobtrackerv1.CreateJobRequest{Company: "Google", Position: "Backend", Description: "Go dev", Salary: 100})
+	assert.NoError(t, err)
+	assert.Equal(t, "PENDING", created.GetStatus())
+	assert.Equal(t, int64(1), created.GetVersion())
+
+	updated, err := client.UpdateJobStatus(ctx, &jobtrackerv1.UpdateJobStatusRequest{Id: created.GetId(), Status: "APPLIED", Version: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), updated.GetVersion())
+
+	_, err = client.UpdateJob(ctx, &jobtrackerv1.UpdateJobRequest{Id: created.GetId(), Company: "Meta", Position: "Backend", Description: "Go dev", Version: 1})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+
+	applied, err := client.ListJobsByStatus(ctx, &jobtrackerv1.ListJobsByStatusRequest{Status: "APPLIED"})
+	assert.NoError(t, err)
+	assert.Len(t, applied.GetJobs(), 1)
+
+	_, err = client.DeleteJob(ctx, &jobtrackerv1.DeleteJobRequest{Id: created.GetId()})
+	assert.NoError(t, err)
+
+	_, err = client.GetJob(ctx, &jobtrackerv1.GetJobRequest{Id: created.GetId()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.GetJob(ctx, &jobtrackerv1.GetJobRequest{Id: "not-a-uuid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.CreateJob(ctx, &jobtrackerv1.CreateJobRequest{Company: "G"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGrpc_WatchJobsReplaysAndFilters(t *testing.T) {
+	client := setupGrpcClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	first, err := client.CreateJob(ctx, &jobtrackerv1.CreateJobRequest{Company: "Google", Position: "Backend", Description: "Go dev"})
+	assert.NoError(t, err)
+	_, err = client.UpdateJobStatus(ctx, &jobtrackerv1.UpdateJobStatusRequest{Id: first.GetId(), Status: "APPLIED"})
+	assert.NoError(t, err)
+	second, err := client.CreateJob(ctx, &jobtrackerv1.CreateJobRequest{Company: "Amazon", Position: "Java", Description: "Spring"})
+	assert.NoError(t, err)
+
+	stream, err := client.WatchJobs(ctx, &jobtrackerv1.WatchJobsRequest{
+		Types:         []string{"job.created"},
+		AfterSequence: proto.Int64(0),
+	})
+	assert.NoError(t, err)
+
+	event, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "job.created", event.GetType())
+	assert.Equal(t, first.GetId(), event.GetJobId())
+	assert.Equal(t, "Google", event.GetJob().GetCompany())
+
+	event, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, second.GetId(), event.GetJobId())
+	assert.Equal(t, int64(3), event.GetSequence())
+
+	invalid, err := client.WatchJobs(ctx, &jobtrackerv1.WatchJobsRequest{JobId: uuid.NewString()[:8]})
+	assert.NoError(t, err)
+	_, err = invalid.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGrpc_WatchJobsByUser(t *testing.T) {
+	client := setupGrpcClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	first, err := client.CreateJob(ctx, &jobtrackerv1.CreateJobRequest{Company: "Google", Position: "Backend", Description: "Go dev", UserId: "alice"})
+	assert.NoError(t, err)
+	_, err = client.CreateJob(ctx, &jobtrackerv1.CreateJobRequest{Company: "Amazon", Position: "Java", Description: "Spring", UserId: "bob"})
+	assert.NoError(t, err)
+	second, err := client.CreateJob(ctx, &jobtrackerv1.CreateJobRequest{Company: "Meta", Position: "Backend", Description: "Go dev", UserId: "alice"})
+	assert.NoError(t, err)
+
+	stream, err := client.WatchJobs(ctx, &jobtrackerv1.WatchJobsRequest{UserId: "alice", AfterSequence: proto.Int64(0)})
+	assert.NoError(t, err)
+
+	for _, want := range []*jobtrackerv1.Job{first, second} {
+		event, err := stream.Recv()
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, want.GetId(), event.GetJobId())
+	}
+}
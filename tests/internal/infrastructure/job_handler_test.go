@@ -0,0 +1,224 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"job-tracker/tests/mocks"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/metric/noop"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+func setupJobRouter(t *testing.T) (*gin.Engine, domain.JobRepository) {
+	gin.SetMode(gin.TestMode)
+	repo := infrastructure.NewJobRepository(setupTestDB(t))
+	service := application.NewJobService(repo, &mocks.LoggerMock{}, tracenoop.NewTracerProvider())
+	r := gin.New()
+	infrastructure.NewJobHandler(service, &mocks.LoggerMock{}).RegisterRoutes(r)
+	return r, repo
+}
+
+func TestUpdateJob_IfMatch(t *testing.T) {
+	r, repo := setupJobRouter(t)
+
+	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	assert.NoError(t, repo.CreateJob(job, context.Background()))
+
+	get := httptest.NewRecorder()
+	r.ServeHTTP(get, httptest.NewRequest(http.MethodGet, "/jobs/"+job.Id.String(), nil))
+	assert.Equal(t, http.StatusOK, get.Code)
+	etag := get.Header().Get("ETag")
+	assert.Equal(t, `"1"`, etag)
+
+	body := `{"id":"` + job.Id.String() + `","company":"Meta","position":"Backend","description":"Go"}`
+
+	put := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPut, "/jobs", strings.NewReader(body))
+	req.Header.Set("If-Match", etag)
+	r.ServeHTTP(put, req)
+	assert.Equal(t, http.StatusOK, put.Code)
+	assert.Equal(t, `"2"`, put.Header().Get("ETag"))
+
+	stale := httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPut, "/jobs", strings.NewReader(body))
+	req.Header.Set("If-Match", etag)
+	r.ServeHTTP(stale, req)
+	assert.Equal(t, http.StatusPreconditionFailed, stale.Code)
+
+	var updated domain.Job
+	assert.NoError(t, json.Unmarshal(put.Body.Bytes(), &updated))
+	assert.Equal(t, "Meta", updated.Company)
+	assert.Equal(t, 2, updated.Version)
+}
+
+func TestPatchJob_ContentTypes(t *testing.T) {
+	r, repo := setupJobRouter(t)
+
+	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	assert.NoError(t, repo.CreateJob(job, context.Background()))
+
+	merge := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPatch, "/jobs/"+job.Id.String(), strings.NewReader(`{"salary":150}`))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	r.ServeHTTP(merge, req)
+	assert.Equal(t, http.StatusOK, merge.Code)
+
+	patch := httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPatch, "/jobs/"+job.Id.String(), strings.NewReader(`[{"op":"replace","path":"/remote","value":false}]`))
+	req.Header.Set("Content-Type", "application/json-patch+json")
+	req.Header.Set("If-Match", `"2"`)
+	r.ServeHTTP(patch, req)
+	assert.Equal(t, http.StatusOK, patch.Code)
+
+	unsupported := httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPatch, "/jobs/"+job.Id.String(), strings.NewReader(`salary=1`))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.ServeHTTP(unsupported, req)
+	assert.Equal(t, http.StatusUnsupportedMediaType, unsupported.Code)
+
+	stored, err := repo.GetJobById(job.Id.String(), context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 150, stored.Salary)
+	assert.False(t, stored.Remote)
+	assert.Equal(t, 3, stored.Version)
+	assert.Equal(t, domain.FieldSourceUser, stored.FieldSources[domain.JobFieldSalary])
+}
+
+type bulkItem struct {
+	Index  int             `json:"index"`
+	Status int             `json:"status"`
+	Job    *domain.Job     `json:"job"`
+	Error  *domain.Problem `json:"error"`
+}
+
+func postBulk(t *testing.T, r *gin.Engine, body string) (int, []bulkItem) {
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/jobs/bulk", strings.NewReader(body)))
+	var items []bulkItem
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &items))
+	return w.Code, items
+}
+
+func TestBulk_AtomicRollsBack(t *testing.T) {
+	r, repo := setupJobRouter(t)
+
+	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	assert.NoError(t, repo.CreateJob(job, context.Background()))
+
+	code, items := postBulk(t, r, `{"operations":[
+		{"op":"create","company":"Meta","position":"Backend","description":"Go"},
+		{"op":"tag","id":"`+job.Id.String()+`","addTags":["Remote"]},
+		{"op":"delete","id":"`+domain.NewJob("x", "y", "z", 0, false, "").Id.String()+`"}
+	]}`)
+
+	assert.Equal(t, http.StatusMultiStatus, code)
+	assert.Len(t, items, 3)
+	assert.Equal(t, http.StatusFailedDependency, items[0].Status)
+	assert.Nil(t, items[0].Job)
+	assert.Equal(t, http.StatusFailedDependency, items[1].Status)
+	assert.Equal(t, http.StatusNotFound, items[2].Status)
+	assert.Equal(t, domain.ErrJobNotFound.Error(), items[2].Error.Detail)
+
+	jobs, _ := repo.GetAll(true, context.Background())
+	assert.Len(t, jobs, 1)
+	assert.Empty(t, jobs[0].Tags)
+}
+
+func TestBulk_BestEffort(t *testing.T) {
+	r, repo := setupJobRouter(t)
+
+	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	assert.NoError(t, repo.CreateJob(job, context.Background()))
+
+	code, items := postBulk(t, r, `{"mode":"best-effort","operations":[
+		{"op":"create","company":"Meta","position":"Backend","description":"Go"},
+		{"op":"update","id":"`+job.Id.String()+`","company":"G","position":"Backend","description":"Go"},
+		{"op":"status","id":"`+job.Id.String()+`","status":"APPLIED","version":1},
+		{"op":"tag","id":"`+job.Id.String()+`","addTags":[" Remote ","go"]}
+	]}`)
+
+	assert.Equal(t, http.StatusMultiStatus, code)
+	assert.Equal(t, http.StatusCreated, items[0].Status)
+	assert.Equal(t, http.StatusBadRequest, items[1].Status)
+	assert.Equal(t, http.StatusOK, items[2].Status)
+	assert.Equal(t, http.StatusOK, items[3].Status)
+	assert.Equal(t, []string{"go", "remote"}, items[3].Job.Tags)
+
+	found, err := repo.GetJobById(job.Id.String(), context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, domain.JobStatusApplied, found.Status)
+	assert.Equal(t, []string{"go", "remote"}, found.Tags)
+	assert.Equal(t, 3, found.Version)
+
+	jobs, _ := repo.GetAll(true, context.Background())
+	assert.Len(t, jobs, 2)
+}
+
+func TestGetJob_IfNoneMatch(t *testing.T) {
+	r, repo := setupJobRouter(t)
+
+	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	assert.NoError(t, repo.CreateJob(job, context.Background()))
+
+	for _, path := range []string{"/jobs/" + job.Id.String(), "/jobs", "/jobs/status/pending"} {
+		get := httptest.NewRecorder()
+		r.ServeHTTP(get, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(t, http.StatusOK, get.Code, path)
+		assert.Equal(t, "private, no-cache", get.Header().Get("Cache-Control"), path)
+		etag := get.Header().Get("ETag")
+		assert.NotEmpty(t, etag, path)
+
+		revalidate := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("If-None-Match", `"other", W/`+etag)
+		r.ServeHTTP(revalidate, req)
+		assert.Equal(t, http.StatusNotModified, revalidate.Code, path)
+		assert.Empty(t, revalidate.Body.String(), path)
+	}
+
+	listETag := func() string {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/jobs", nil))
+		return w.Header().Get("ETag")
+	}
+	before := listETag()
+	job.ChangeStatus(domain.JobStatusApplied)
+	assert.NoError(t, repo.UpdateJob(job, context.Background()))
+	assert.NotEqual(t, before, listETag())
+}
+
+func TestGetJob_CacheControlNoCacheBypassesCache(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db := setupTestDB(t)
+	repo := infrastructure.NewCachedJobRepository(infrastructure.NewJobRepository(db), infrastructure.NewMemoryJobCache(10, time.Minute), &mocks.LoggerMock{}, noop.NewMeterProvider())
+	r := gin.New()
+	infrastructure.NewJobHandler(application.NewJobService(repo, &mocks.LoggerMock{}, tracenoop.NewTracerProvider()), &mocks.LoggerMock{}).RegisterRoutes(r)
+
+	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	assert.NoError(t, repo.CreateJob(job, context.Background()))
+	company := func(cacheControl string) string {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/jobs/"+job.Id.String(), nil)
+		req.Header.Set("Cache-Control", cacheControl)
+		r.ServeHTTP(w, req)
+		var found domain.Job
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &found))
+		return found.Company
+	}
+
+	assert.Equal(t, "Google", company(""))
+	assert.NoError(t, db.Model(&domain.Job{}).Where("id = ?", job.Id).Update("company", "Meta").Error)
+	assert.Equal(t, "Google", company(""))
+	assert.Equal(t, "Meta", company("no-cache"))
+	assert.Equal(t, "Meta", company(""))
+}
@@ -0,0 +1,117 @@
+package infrastructure
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"job-tracker/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func newMetricReader() (*sdkmetric.ManualReader, *sdkmetric.MeterProvider) {
+	reader := sdkmetric.NewManualReader()
+	return reader, sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+}
+
+// dataPoints collects the named instrument and returns the value of each
+// data point keyed by its attributes.
+func dataPoints(t *testing.T, reader *sdkmetric.ManualReader, name string) map[attribute.Distinct]int64 {
+	var rm metricdata.ResourceMetrics
+	assert.NoError(t, reader.Collect(context.Background(), &rm))
+	points := map[attribute.Distinct]int64{}
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name != name {
+				continue
+			}
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				for _, point := range data.DataPoints {
+					points[point.Attributes.Equivalent()] = point.Value
+				}
+			case metricdata.Gauge[int64]:
+				for _, point := range data.DataPoints {
+					points[point.Attributes.Equivalent()] = point.Value
+				}
+			case metricdata.Histogram[float64]:
+				for _, point := range data.DataPoints {
+					points[point.Attributes.Equivalent()] = int64(point.Count)
+				}
+			}
+		}
+	}
+	return points
+}
+
+func attributes(kv ...attribute.KeyValue) attribute.Distinct {
+	set := attribute.NewSet(kv...)
+	return set.Equivalent()
+}
+
+func TestJobMetrics_CountsOutboxEvents(t *testing.T) {
+	db := setupTestDB(t)
+	repo := infrastructure.NewJobRepository(db)
+	outbox := infrastructure.NewOutboxRepository(db)
+	reader, provider := newMetricReader()
+	metrics := infrastructure.NewJobMetrics(repo, &mocks.LoggerMock{}, provider)
+
+	applied := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	assert.NoError(t, repo.CreateJob(applied, context.Background()))
+	applied.ChangeStatus(domain.JobStatusApplied)
+	assert.NoError(t, repo.UpdateJob(applied, context.Background()))
+	assert.NoError(t, repo.CreateJob(domain.NewJob("Meta", "Frontend", "React", 100, true, ""), context.Background()))
+
+	events, err := outbox.GetEventsAfter(0, 10, context.Background())
+	assert.NoError(t, err)
+	for _, event := range events {
+		assert.NoError(t, metrics.Handle(context.Background(), event))
+	}
+
+	assert.Equal(t, map[attribute.Distinct]int64{
+		attributes(attribute.String("job.status", "PENDING")): 2,
+	}, dataPoints(t, reader, "job_tracker.jobs.created"))
+	assert.Equal(t, map[attribute.Distinct]int64{
+		attributes(attribute.String("job.status.from", "PENDING"), attribute.String("job.status.to", "APPLIED")): 1,
+	}, dataPoints(t, reader, "job_tracker.jobs.status_transitions"))
+}
+
+func TestJobMetrics_SkipsEventsBeforeStart(t *testing.T) {
+	reader, provider := newMetricReader()
+	metrics := infrastructure.NewJobMetrics(infrastructure.NewJobRepository(setupTestDB(t)), &mocks.LoggerMock{}, provider)
+
+	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	event := job.PullEvents()[0]
+	event.OccurredAt = time.Now().Add(-time.Hour)
+	assert.NoError(t, metrics.Handle(context.Background(), event))
+
+	assert.Empty(t, dataPoints(t, reader, "job_tracker.jobs.created"))
+}
+
+func TestJobMetrics_ObservesActiveJobsPerStatus(t *testing.T) {
+	db := setupTestDB(t)
+	repo := infrastructure.NewJobRepository(db)
+	reader, provider := newMetricReader()
+	infrastructure.NewJobMetrics(repo, &mocks.LoggerMock{}, provider)
+
+	interview := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	interview.ChangeStatus(domain.JobStatusInterview)
+	archived := domain.NewJob("Meta", "Frontend", "React", 100, true, "")
+	archived.Archive(domain.FieldSourceUser)
+	deleted := domain.NewJob("Netflix", "Backend", "Java", 100, true, "")
+	for _, job := range []*domain.Job{interview, archived, deleted, domain.NewJob("Apple", "iOS", "Swift", 100, false, "")} {
+		assert.NoError(t, repo.CreateJob(job, context.Background()))
+	}
+	assert.NoError(t, repo.DeleteJob(deleted.Id.String(), context.Background()))
+
+	points := dataPoints(t, reader, "job_tracker.jobs.active")
+	assert.Len(t, points, len(domain.JobStatuses))
+	assert.Equal(t, int64(1), points[attributes(attribute.String("job.status", "INTERVIEW"))])
+	assert.Equal(t, int64(1), points[attributes(attribute.String("job.status", "PENDING"))])
+	assert.Equal(t, int64(0), points[attributes(attribute.String("job.status", "OFFER"))])
+}
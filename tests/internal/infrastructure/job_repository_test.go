@@ -1,21 +1,29 @@
 package infrastructure
 
 import (
+	"context"
+	"job-tracker/internal/application"
 	"job-tracker/internal/domain"
 	"job-tracker/internal/infrastructure"
+	"job-tracker/tests/mocks"
+	"path/filepath"
 	"testing"
+	"time"
 
-	"github.com/glebarez/sqlite"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
 )
 
+// setupTestDB runs the repository suite against a SQLite file opened the way
+// DB_DRIVER=sqlite opens it in production.
 func setupTestDB(t *testing.T) *gorm.DB {
-	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	db, err := infrastructure.OpenSQLite(filepath.Join(t.TempDir(), "jobs.db"), &gorm.Config{})
 	assert.NoError(t, err)
 
-	err = db.AutoMigrate(&domain.Job{})
+	migrator, err := infrastructure.NewMigrator(db)
 	assert.NoError(t, err)
+	assert.NoError(t, migrator.Up(context.Background()))
 
 	return db
 }
@@ -26,11 +34,11 @@ func TestCreateAndGetJob(t *testing.T) {
 
 	job := domain.NewJob("Google", "Backend", "Go dev", 100000, true, "")
 
-	err := repo.CreateJob(job)
+	err := repo.CreateJob(job, context.Background())
 	assert.NoError(t, err)
 	assert.NotEmpty(t, job.Id)
 
-	found, err := repo.GetJobById(job.Id.String())
+	found, err := repo.GetJobById(job.Id.String(), context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, "Google", found.Company)
 	assert.Equal(t, "Backend", found.Position)
@@ -43,10 +51,10 @@ func TestGetAllJobs(t *testing.T) {
 	job1 := domain.NewJob("Google", "Backend", "Go", 100, true, "")
 	job2 := domain.NewJob("Amazon", "Java", "Spring", 200, false, "")
 
-	_ = repo.CreateJob(job1)
-	_ = repo.CreateJob(job2)
+	_ = repo.CreateJob(job1, context.Background())
+	_ = repo.CreateJob(job2, context.Background())
 
-	jobs, err := repo.GetAll()
+	jobs, err := repo.GetAll(false, context.Background())
 
 	assert.NoError(t, err)
 	assert.Len(t, jobs, 2)
@@ -57,14 +65,14 @@ func TestUpdateJob(t *testing.T) {
 	repo := infrastructure.NewJobRepository(db)
 
 	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
-	_ = repo.CreateJob(job)
+	_ = repo.CreateJob(job, context.Background())
 
 	job.Company = "Meta"
-	err := repo.UpdateJob(job)
+	err := repo.UpdateJob(job, context.Background())
 
 	assert.NoError(t, err)
 
-	updated, _ := repo.GetJobById(job.Id.String())
+	updated, _ := repo.GetJobById(job.Id.String(), context.Background())
 	assert.Equal(t, "Meta", updated.Company)
 }
 
@@ -73,12 +81,12 @@ func TestDeleteJob(t *testing.T) {
 	repo := infrastructure.NewJobRepository(db)
 
 	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
-	_ = repo.CreateJob(job)
+	_ = repo.CreateJob(job, context.Background())
 
-	err := repo.DeleteJob(job.Id.String())
+	err := repo.DeleteJob(job.Id.String(), context.Background())
 	assert.NoError(t, err)
 
-	_, err = repo.GetJobById(job.Id.String())
+	_, err = repo.GetJobById(job.Id.String(), context.Background())
 	assert.Error(t, err)
 }
 
@@ -86,8 +94,144 @@ func TestDeleteJob_NotFound(t *testing.T) {
 	db := setupTestDB(t)
 	repo := infrastructure.NewJobRepository(db)
 
-	err := repo.DeleteJob("non-existing")
+	err := repo.DeleteJob("non-existing", context.Background())
 
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrJobNotFound, err)
 }
+
+func TestUpdateJob_VersionConflict(t *testing.T) {
+	db := setupTestDB(t)
+	repo := infrastructure.NewJobRepository(db)
+
+	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	_ = repo.CreateJob(job, context.Background())
+
+	userCopy, _ := repo.GetJobById(job.Id.String(), context.Background())
+	scraperCopy, _ := repo.GetJobById(job.Id.String(), context.Background())
+
+	userCopy.Update("Google", "Backend", "Hand-written description", 100, true, "")
+	assert.NoError(t, repo.UpdateJob(userCopy, context.Background()))
+	assert.Equal(t, 2, userCopy.Version)
+
+	scraperCopy.ApplyScraped("Scraped description", "")
+	err := repo.UpdateJob(scraperCopy, context.Background())
+	assert.ErrorIs(t, err, domain.ErrVersionConflict)
+	assert.Equal(t, 1, scraperCopy.Version)
+
+	stored, _ := repo.GetJobById(job.Id.String(), context.Background())
+	assert.Equal(t, "Hand-written description", stored.Description)
+	assert.Equal(t, 2, stored.Version)
+}
+
+func TestSoftDeleteAndRestore(t *testing.T) {
+	db := setupTestDB(t)
+	repo := infrastructure.NewJobRepository(db)
+
+	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	_ = repo.CreateJob(job, context.Background())
+	assert.NoError(t, repo.DeleteJob(job.Id.String(), context.Background()))
+
+	jobs, err := repo.GetAll(false, context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, jobs)
+
+	trash, err := repo.GetDeleted(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, trash, 1)
+	assert.True(t, trash[0].DeletedAt.Valid)
+
+	assert.ErrorIs(t, repo.DeleteJob(job.Id.String(), context.Background()), domain.ErrJobNotFound)
+
+	restored, err := repo.RestoreJob(job.Id.String(), context.Background())
+	assert.NoError(t, err)
+	assert.False(t, restored.DeletedAt.Valid)
+	assert.Equal(t, 2, restored.Version)
+
+	found, err := repo.GetJobById(job.Id.String(), context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, found.Version)
+
+	_, err = repo.RestoreJob(job.Id.String(), context.Background())
+	assert.ErrorIs(t, err, domain.ErrJobNotFound)
+}
+
+func TestPurgeDeleted(t *testing.T) {
+	db := setupTestDB(t)
+	repo := infrastructure.NewJobRepository(db)
+	reminders := infrastructure.NewReminderRepository(db)
+
+	old := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	recent := domain.NewJob("Amazon", "Java", "Spring", 200, false, "")
+	_ = repo.CreateJob(old, context.Background())
+	_ = repo.CreateJob(recent, context.Background())
+	_ = reminders.CreateReminder(domain.NewReminder(old.Id, time.Now(), "follow up", domain.ReminderRecurrenceNone), context.Background())
+	_ = repo.DeleteJob(old.Id.String(), context.Background())
+	db.Unscoped().Model(&domain.Job{}).Where("id = ?", old.Id).Update("deleted_at", time.Now().AddDate(0, 0, -40))
+	_ = repo.DeleteJob(recent.Id.String(), context.Background())
+
+	purged, err := repo.PurgeDeleted(time.Now().AddDate(0, 0, -30), context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+
+	trash, _ := repo.GetDeleted(context.Background())
+	assert.Len(t, trash, 1)
+	assert.Equal(t, recent.Id, trash[0].Id)
+
+	pending, _ := reminders.GetPending(context.Background())
+	assert.Empty(t, pending)
+}
+
+func TestArchiveJobs_FailedWriteArchivesNothing(t *testing.T) {
+	db := setupTestDB(t)
+	repo := infrastructure.NewJobRepository(db)
+	service := application.NewArchiveService(repo, &mocks.LoggerMock{})
+
+	first := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	second := domain.NewJob("Amazon", "Java", "Spring", 200, false, "")
+	for _, job := range []*domain.Job{first, second} {
+		assert.NoError(t, repo.CreateJob(job, context.Background()))
+	}
+	assert.NoError(t, db.Exec("CREATE TRIGGER fail_archive BEFORE UPDATE ON jobs WHEN NEW.id = '"+second.Id.String()+"' BEGIN SELECT RAISE(ABORT, 'disk full'); END").Error)
+
+	_, err := service.ArchiveJobs(&application.ArchiveJobsRequest{Ids: []uuid.UUID{first.Id, second.Id}}, context.Background())
+
+	assert.Error(t, err)
+	found, err := repo.GetJobById(first.Id.String(), context.Background())
+	assert.NoError(t, err)
+	assert.False(t, found.Archived)
+}
+
+func TestGetAll_ExcludesArchived(t *testing.T) {
+	db := setupTestDB(t)
+	repo := infrastructure.NewJobRepository(db)
+
+	active := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	archived := domain.NewJob("Amazon", "Java", "Spring", 200, false, "")
+	_ = repo.CreateJob(active, context.Background())
+	_ = repo.CreateJob(archived, context.Background())
+	archived.Archive(domain.FieldSourceUser)
+	assert.NoError(t, repo.UpdateJob(archived, context.Background()))
+
+	jobs, err := repo.GetAll(false, context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 1)
+	assert.Equal(t, active.Id, jobs[0].Id)
+
+	jobs, err = repo.GetAll(true, context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 2)
+
+	pending, err := repo.GetJobsByStatus(domain.JobStatusPending, false, context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, pending, 1)
+}
+
+func TestGetAll_CancelledContext(t *testing.T) {
+	repo := infrastructure.NewJobRepository(setupTestDB(t))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := repo.GetAll(false, ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
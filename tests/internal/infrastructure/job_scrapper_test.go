@@ -0,0 +1,158 @@
+package infrastructure
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"job-tracker/tests/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const postingPage = `<html><body><div class="description__text--rich"><p>Build APIs in Go</p></div></body></html>`
+
+// startScrapper serves one posting that scrapes fine and one that is gone,
+// saves a job for each and scrapes them every few milliseconds until the
+// test ends.
+func startScrapper(t *testing.T, reader *sdkmetric.ManualReader, provider *sdktrace.TracerProvider) map[string]*domain.Job {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(postingPage))
+	}))
+	t.Cleanup(server.Close)
+
+	repo := infrastructure.NewJobRepository(setupTestDB(t))
+	jobs := map[string]*domain.Job{}
+	for _, path := range []string{"/posting", "/missing"} {
+		jobs[path] = domain.NewJob("Google", "Backend", "", 100, true, server.URL+path)
+		assert.NoError(t, repo.CreateJob(jobs[path], context.Background()))
+	}
+	notifier := &mocks.NotifierMock{}
+	notifier.On("Notify", mock.Anything).Return(nil)
+	scrapper := infrastructure.NewJobScrapper(repo, notifier, &mocks.LoggerMock{},
+		infrastructure.ScrapeConfig{Interval: 10 * time.Millisecond, Concurrency: 2},
+		sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)), provider)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() { _ = scrapper.InitScrape(ctx) }()
+	return jobs
+}
+
+func TestJobScrapper_RecordsScrapeMetrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	startScrapper(t, reader, sdktrace.NewTracerProvider())
+
+	host := attribute.String("server.address", "127.0.0.1")
+	assert.Eventually(t, func() bool {
+		return dataPoints(t, reader, "job_tracker.scrape.successes")[attributes(host)] >= 1 &&
+			dataPoints(t, reader, "job_tracker.scrape.failures")[attributes(host)] >= 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.GreaterOrEqual(t, dataPoints(t, reader, "job_tracker.scrape.attempts")[attributes(host)], int64(2))
+	duration := dataPoints(t, reader, "job_tracker.scrape.duration")
+	assert.GreaterOrEqual(t, duration[attributes(host, attribute.String("outcome", "failure"))], int64(1))
+	fields := dataPoints(t, reader, "job_tracker.scrape.fields")
+	assert.GreaterOrEqual(t, fields[attributes(attribute.String("field", "description"), attribute.Bool("extracted", true))], int64(1))
+}
+
+func TestJobScrapper_TracesEachSweep(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	jobs := startScrapper(t, sdkmetric.NewManualReader(), sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	var sweep sdktrace.ReadOnlySpan
+	assert.Eventually(t, func() bool {
+		for _, span := range recorder.Ended() {
+			if span.Name() == "JobScrapper.scrape" {
+				sweep = span
+				return true
+			}
+		}
+		return false
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.False(t, sweep.Parent().IsValid())
+	assert.Contains(t, sweep.Attributes(), attribute.Int("job.count", 2))
+
+	runs := map[string]sdktrace.ReadOnlySpan{}
+	requests := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		if span.Name() == "JobScrapper.run" && span.Parent().SpanID() == sweep.SpanContext().SpanID() {
+			id, _ := spanAttribute(span, "job.id")
+			runs[id.AsString()] = span
+			requests[span.SpanContext().SpanID().String()] = nil
+		}
+	}
+	assert.Len(t, runs, 2)
+	for _, span := range recorder.Ended() {
+		if _, ok := requests[span.Parent().SpanID().String()]; ok && span.SpanKind() == trace.SpanKindClient {
+			requests[span.Parent().SpanID().String()] = span
+		}
+	}
+
+	posting := runs[jobs["/posting"].Id.String()]
+	assert.Contains(t, posting.Attributes(), attribute.String("server.address", "127.0.0.1"))
+	assert.Contains(t, posting.Attributes(), attribute.Int("http.response.status_code", http.StatusOK))
+	assert.Contains(t, posting.Attributes(), attribute.Int("http.response.body.size", len(postingPage)))
+	assert.Equal(t, codes.Unset, posting.Status().Code)
+	assert.NotNil(t, requests[posting.SpanContext().SpanID().String()])
+
+	missing := runs[jobs["/missing"].Id.String()]
+	assert.Contains(t, missing.Attributes(), attribute.Int("http.response.status_code", http.StatusNotFound))
+	assert.Equal(t, codes.Error, missing.Status().Code)
+	assert.Equal(t, "unexpected HTTP status 404", missing.Status().Description)
+	assert.Len(t, missing.Events(), 1)
+	assert.NotNil(t, requests[missing.SpanContext().SpanID().String()])
+}
+
+func TestJobScrapper_NotifiesClosedPostingOfTrackedJob(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html><body><span class="job-status">Closed</span></body></html>`))
+	}))
+	t.Cleanup(server.Close)
+
+	repo := infrastructure.NewJobRepository(setupTestDB(t))
+	job := domain.NewJob("Google", "Backend", "Go dev", 100, true, server.URL)
+	job.ChangeStatus(domain.JobStatusApplied)
+	assert.NoError(t, repo.CreateJob(job, context.Background()))
+
+	notified := make(chan domain.Notification, 10)
+	notifier := &mocks.NotifierMock{}
+	notifier.On("Notify", mock.Anything).Run(func(args mock.Arguments) {
+		notified <- args.Get(0).(domain.Notification)
+	}).Return(nil)
+	scrapper := infrastructure.NewJobScrapper(repo, notifier, &mocks.LoggerMock{},
+		infrastructure.ScrapeConfig{Interval: 10 * time.Millisecond, Concurrency: 1},
+		sdkmetric.NewMeterProvider(), sdktrace.NewTracerProvider())
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() { _ = scrapper.InitScrape(ctx) }()
+
+	select {
+	case notification := <-notified:
+		assert.Equal(t, domain.NotificationPostingClosed, notification.Event)
+	case <-time.After(5 * time.Second):
+		t.Fatal("posting closed was not notified")
+	}
+	// Later sweeps see the posting already closed and stay quiet.
+	time.Sleep(50 * time.Millisecond)
+	assert.Empty(t, notified)
+
+	saved, err := repo.GetJobById(job.Id.String(), context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, domain.JobStatusApplied, saved.Status)
+	assert.NotNil(t, saved.PostingClosedAt)
+}
@@ -0,0 +1,197 @@
+package infrastructure
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+var persistedModels = []any{
+	&domain.Job{},
+	&domain.Reminder{},
+	&domain.NotificationChannel{},
+	&domain.NotificationDelivery{},
+	&domain.WebhookSubscription{},
+	&domain.WebhookDelivery{},
+	&domain.OutboxMessage{},
+	&domain.OutboxCheckpoint{},
+}
+
+func openMigrator(t *testing.T) (*gorm.DB, *infrastructure.Migrator) {
+	db, err := infrastructure.OpenSQLite(filepath.Join(t.TempDir(), "jobs.db"), &gorm.Config{})
+	assert.NoError(t, err)
+	migrator, err := infrastructure.NewMigrator(db)
+	assert.NoError(t, err)
+	return db, migrator
+}
+
+// TestMigrations_MatchModels keeps the SQL files and the GORM models in step:
+// every column and index a model declares must exist after migrating.
+func TestMigrations_MatchModels(t *testing.T) {
+	db, migrator := openMigrator(t)
+	assert.NoError(t, migrator.Up(context.Background()))
+
+	for _, model := range persistedModels {
+		parsed, err := schema.Parse(model, &sync.Map{}, db.NamingStrategy)
+		assert.NoError(t, err)
+		assert.True(t, db.Migrator().HasTable(model), parsed.Table)
+		for _, field := range parsed.Fields {
+			if field.DBName != "" {
+				assert.True(t, db.Migrator().HasColumn(model, field.DBName), "%s.%s", parsed.Table, field.DBName)
+			}
+		}
+		for _, index := range parsed.ParseIndexes() {
+			assert.True(t, db.Migrator().HasIndex(model, index.Name), "%s.%s", parsed.Table, index.Name)
+		}
+	}
+}
+
+func TestMigrations_DownAndUp(t *testing.T) {
+	db, migrator := openMigrator(t)
+	ctx := context.Background()
+	assert.NoError(t, migrator.Up(ctx))
+	status, err := migrator.Status(ctx)
+	assert.NoError(t, err)
+
+	assert.NoError(t, migrator.Down(len(status.Migrations), ctx))
+	status, err = migrator.Status(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, status.Version)
+	assert.False(t, status.Migrations[0].Applied)
+	assert.False(t, db.Migrator().HasTable(&domain.Job{}))
+
+	assert.NoError(t, migrator.Up(ctx))
+	status, err = migrator.Status(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, status.Migrations[len(status.Migrations)-1].Version, status.Version)
+	assert.False(t, status.Dirty)
+	assert.True(t, db.Migrator().HasTable(&domain.Job{}))
+}
+
+func TestMigrations_AdoptAutoMigratedDatabase(t *testing.T) {
+	db, migrator := openMigrator(t)
+	assert.NoError(t, db.AutoMigrate(persistedModels...))
+	repo := infrastructure.NewJobRepository(db)
+	job := domain.NewJob("Google", "Backend", "Go dev", 0, false, "")
+	assert.NoError(t, repo.CreateJob(job, context.Background()))
+
+	assert.NoError(t, migrator.Up(context.Background()))
+
+	found, err := repo.GetJobById(job.Id.String(), context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "Google", found.Company)
+}
+
+// baselineJob is the jobs table as the first release AutoMigrated it.
+type baselineJob struct {
+	Id          uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Company     string
+	Position    string
+	Description string
+	Status      string
+	Salary      int
+	Remote      bool
+	Url         string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (baselineJob) TableName() string {
+	return "jobs"
+}
+
+func TestMigrations_AdoptBaselineDatabase(t *testing.T) {
+	db, migrator := openMigrator(t)
+	ctx := context.Background()
+	assert.NoError(t, db.AutoMigrate(&baselineJob{}))
+	id := uuid.New()
+	assert.NoError(t, db.Create(&baselineJob{Id: id, Company: "Google", Position: "Backend", Status: "APPLIED", CreatedAt: time.Now(), UpdatedAt: time.Now()}).Error)
+
+	assert.NoError(t, migrator.Up(ctx))
+
+	status, err := migrator.Status(ctx)
+	assert.NoError(t, err)
+	assert.False(t, status.Dirty)
+	repo := infrastructure.NewJobRepository(db)
+	found, err := repo.GetJobById(id.String(), ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "Google", found.Company)
+	assert.Equal(t, 1, found.Version)
+	assert.False(t, found.Archived)
+	jobs, err := repo.GetAll(false, ctx)
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 1)
+	assert.True(t, db.Migrator().HasIndex(&domain.Job{}, "idx_jobs_deleted_at"))
+}
+
+func TestMigrations_RefuseDirtySchema(t *testing.T) {
+	db, migrator := openMigrator(t)
+	ctx := context.Background()
+	assert.NoError(t, migrator.Up(ctx))
+	assert.NoError(t, db.Exec("UPDATE schema_migrations SET dirty = ?", true).Error)
+
+	err := migrator.Up(ctx)
+	assert.ErrorIs(t, err, infrastructure.ErrDirtySchema)
+	err = migrator.Down(1, ctx)
+	assert.ErrorIs(t, err, infrastructure.ErrDirtySchema)
+
+	assert.NoError(t, migrator.Force(1, ctx))
+	status, err := migrator.Status(ctx)
+	assert.NoError(t, err)
+	assert.False(t, status.Dirty)
+	assert.NoError(t, migrator.Up(ctx))
+}
+
+func TestMigrations_ConcurrentUp(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jobs.db")
+	var wg sync.WaitGroup
+	errs := make(chan error, 4)
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			db, err := infrastructure.OpenSQLite(path, &gorm.Config{})
+			if err == nil {
+				var migrator *infrastructure.Migrator
+				if migrator, err = infrastructure.NewMigrator(db); err == nil {
+					err = migrator.Up(context.Background())
+				}
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+}
+
+func TestMigrations_FailureMarksDirty(t *testing.T) {
+	db, migrator := openMigrator(t)
+	ctx := context.Background()
+	// A reminders table without the indexed columns makes the second
+	// migration fail.
+	assert.NoError(t, db.Exec("CREATE TABLE reminders (id uuid PRIMARY KEY)").Error)
+
+	assert.Error(t, migrator.Up(ctx))
+
+	status, err := migrator.Status(ctx)
+	assert.NoError(t, err)
+	assert.True(t, status.Dirty)
+	assert.Equal(t, 2, status.Version)
+	assert.True(t, db.Migrator().HasTable(&domain.Job{}))
+	assert.False(t, db.Migrator().HasColumn(&domain.Job{}, "deleted_at"))
+	assert.False(t, db.Migrator().HasTable(&domain.NotificationChannel{}))
+	assert.ErrorIs(t, migrator.Up(ctx), infrastructure.ErrDirtySchema)
+}
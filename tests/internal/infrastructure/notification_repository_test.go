@@ -0,0 +1,55 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEnabledChannels_OnlyTheUsers(t *testing.T) {
+	repo := infrastructure.NewNotificationRepository(setupTestDB(t))
+	events := []domain.NotificationEvent{domain.NotificationStatusChanged}
+	alice := domain.NewNotificationChannel("alice", domain.ChannelKindWebhook, "http://alice", "s", events)
+	disabled := domain.NewNotificationChannel("alice", domain.ChannelKindWebhook, "http://alice/off", "s", events)
+	disabled.Update(events, false)
+	bob := domain.NewNotificationChannel("bob", domain.ChannelKindWebhook, "http://bob", "s", events)
+	for _, channel := range []*domain.NotificationChannel{alice, disabled, bob} {
+		assert.NoError(t, repo.CreateChannel(channel, context.Background()))
+	}
+
+	channels, err := repo.GetEnabledChannels("alice", context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, channels, 1)
+	assert.Equal(t, alice.Id, channels[0].Id)
+}
+
+func TestGetDueDeliveries(t *testing.T) {
+	repo := infrastructure.NewNotificationRepository(setupTestDB(t))
+	channelId := uuid.New()
+	notification := domain.Notification{Event: domain.NotificationReminderDue, Subject: "Reminder"}
+	now := time.Now()
+
+	due := domain.NewNotificationDelivery(channelId, notification)
+	retrying := domain.NewNotificationDelivery(channelId, notification)
+	retrying.Failed(errors.New("timeout"), now, 3, time.Minute)
+	delivered := domain.NewNotificationDelivery(channelId, notification)
+	delivered.Delivered(now)
+	for _, delivery := range []*domain.NotificationDelivery{due, retrying, delivered} {
+		assert.NoError(t, repo.CreateDelivery(delivery, context.Background()))
+	}
+
+	deliveries, err := repo.GetDueDeliveries(now.Add(time.Second), 10, context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, deliveries, 1)
+	assert.Equal(t, due.Id, deliveries[0].Id)
+
+	deliveries, err = repo.GetDueDeliveries(now.Add(2*time.Minute), 10, context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, deliveries, 2)
+}
@@ -0,0 +1,160 @@
+package infrastructure
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// startSMTPServer runs a minimal in-process SMTP server and returns its port
+// along with a channel receiving the DATA section of each message.
+func startSMTPServer(t *testing.T) (int, <-chan string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	messages := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		write := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+		write("220 localhost ESMTP")
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			command := strings.ToUpper(strings.TrimSpace(line))
+			switch {
+			case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+				write("250 localhost")
+			case command == "DATA":
+				write("354 end data with <CR><LF>.<CR><LF>")
+				var data strings.Builder
+				for {
+					dataLine, err := reader.ReadString('\n')
+					if err != nil || dataLine == ".\r\n" {
+						break
+					}
+					data.WriteString(dataLine)
+				}
+				messages <- data.String()
+				write("250 OK")
+			case command == "QUIT":
+				write("221 bye")
+				return
+			default:
+				write("250 OK")
+			}
+		}
+	}()
+
+	return listener.Addr().(*net.TCPAddr).Port, messages
+}
+
+func TestSMTPSender(t *testing.T) {
+	port, messages := startSMTPServer(t)
+	sender := infrastructure.NewSMTPSender(infrastructure.SMTPConfig{
+		Host: "127.0.0.1",
+		Port: port,
+		From: "tracker@example.com",
+	})
+	channel := domain.NewNotificationChannel("alice", domain.ChannelKindEmail, "alice@example.com", "", nil)
+
+	err := sender.Send(context.Background(), channel, domain.Notification{Subject: "Follow up", Message: "Ping the recruiter"})
+
+	assert.NoError(t, err)
+	message := <-messages
+	assert.Contains(t, message, "To: alice@example.com")
+	assert.Contains(t, message, "Subject: Follow up")
+	assert.Contains(t, message, "Ping the recruiter")
+}
+
+func TestSMTPSender_HeaderInjection(t *testing.T) {
+	port, messages := startSMTPServer(t)
+	sender := infrastructure.NewSMTPSender(infrastructure.SMTPConfig{
+		Host: "127.0.0.1",
+		Port: port,
+		From: "tracker@example.com",
+	})
+	channel := domain.NewNotificationChannel("alice", domain.ChannelKindEmail, "alice@example.com", "", nil)
+
+	err := sender.Send(context.Background(), channel, domain.Notification{Subject: "Señor Dev\r\nBcc: eve@example.com", Message: "Posting closed"})
+
+	assert.NoError(t, err)
+	message := <-messages
+	assert.NotContains(t, message, "\r\nBcc:")
+	assert.Contains(t, message, "Subject: =?utf-8?q?Se=C3=B1or_Dev_Bcc:_eve@example.com?=")
+
+	channel.Target = "alice@example.com\r\nBcc: eve@example.com"
+	assert.Error(t, sender.Send(context.Background(), channel, domain.Notification{Subject: "Closed", Message: "Posting closed"}))
+}
+
+func TestSlackSender(t *testing.T) {
+	var payload map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	channel := domain.NewNotificationChannel("alice", domain.ChannelKindSlack, server.URL, "", nil)
+	err := infrastructure.NewSlackSender().Send(context.Background(), channel, domain.Notification{Subject: "Closed", Message: "Posting closed"})
+
+	assert.NoError(t, err)
+	assert.Contains(t, payload["text"], "Posting closed")
+	assert.Equal(t, payload["text"], payload["content"])
+}
+
+func TestWebhookSender_Signed(t *testing.T) {
+	var body []byte
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		header = r.Header
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	channel := domain.NewNotificationChannel("alice", domain.ChannelKindWebhook, server.URL, "top-secret", nil)
+	notification := domain.Notification{Event: domain.NotificationScrapeFailed, Subject: "Scrape failed", JobId: uuid.New()}
+
+	err := infrastructure.NewWebhookSender().Send(context.Background(), channel, notification)
+
+	assert.NoError(t, err)
+	timestamp := header.Get(infrastructure.TimestampHeader)
+	_, err = strconv.ParseInt(timestamp, 10, 64)
+	assert.NoError(t, err)
+	assert.Equal(t, string(domain.NotificationScrapeFailed), header.Get(infrastructure.EventHeader))
+	assert.Equal(t, "sha256="+infrastructure.SignPayload("top-secret", timestamp, body), header.Get(infrastructure.SignatureHeader))
+}
+
+func TestWebhookSender_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	channel := domain.NewNotificationChannel("alice", domain.ChannelKindWebhook, server.URL, "", nil)
+	err := infrastructure.NewWebhookSender().Send(context.Background(), channel, domain.Notification{})
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,171 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"job-tracker/internal/application"
+	"job-tracker/internal/bootstrap"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"job-tracker/tests/mocks"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type openAPIDocument struct {
+	OpenAPI string                                `json:"openapi"`
+	Paths   map[string]map[string]json.RawMessage `json:"paths"`
+
+	Components struct {
+		Schemas map[string]struct {
+			Properties map[string]json.RawMessage `json:"properties"`
+			Required   []string                   `json:"required"`
+		} `json:"schemas"`
+	} `json:"components"`
+}
+
+// setupAPIRouter mounts the API routes the way bootstrap does. Services are
+// nil because only the route table is inspected.
+func setupAPIRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	logger := &mocks.LoggerMock{}
+	r := gin.New()
+	bootstrap.RegisterAPIRoutes(r, &bootstrap.App{
+		JobHandler:          infrastructure.NewJobHandler(nil, logger),
+		ReminderHandler:     infrastructure.NewReminderHandler(nil, logger),
+		NotificationHandler: infrastructure.NewNotificationHandler(nil, logger),
+		WebhookHandler:      infrastructure.NewWebhookHandler(nil, logger),
+		EventStreamHandler:  infrastructure.NewEventStreamHandler(nil, logger),
+		TrashHandler:        infrastructure.NewTrashHandler(nil, logger),
+		ArchiveHandler:      infrastructure.NewArchiveHandler(nil, logger),
+		DocsHandler:         infrastructure.NewDocsHandler(),
+		GraphQLHandler:      infrastructure.NewGraphQLHandler(nil, nil, nil, logger),
+	})
+	return r
+}
+
+func loadOpenAPI(t *testing.T) openAPIDocument {
+	var document openAPIDocument
+	assert.NoError(t, json.Unmarshal(infrastructure.OpenAPISpec(), &document))
+	return document
+}
+
+var ginParam = regexp.MustCompile(`[:*]([^/]+)`)
+
+func TestOpenAPI_MatchesRouter(t *testing.T) {
+	document := loadOpenAPI(t)
+	assert.Equal(t, "3.1.0", document.OpenAPI)
+
+	var routed []string
+	for _, route := range setupAPIRouter().Routes() {
+		path := ginParam.ReplaceAllString(strings.TrimPrefix(route.Path, "/api/v1"), "{$1}")
+		routed = append(routed, route.Method+" "+path)
+	}
+
+	var documented []string
+	for path, operations := range document.Paths {
+		for method := range operations {
+			if method == "parameters" {
+				continue
+			}
+			documented = append(documented, strings.ToUpper(method)+" "+path)
+		}
+	}
+
+	slices.Sort(routed)
+	slices.Sort(documented)
+	assert.Equal(t, routed, documented, "router and openapi.json diverge")
+}
+
+func TestOpenAPI_MatchesSchemas(t *testing.T) {
+	document := loadOpenAPI(t)
+
+	// PatchJobRequest is built from the raw body and documented as
+	// JobMergePatch and JsonPatch instead.
+	types := map[string]any{
+		"CreateJobRequest":       application.CreateJobRequest{},
+		"UpdateJobRequest":       application.UpdateJobRequest{},
+		"UpdateJobStatusRequest": application.UpdateJobStatusRequest{},
+		"ArchiveJobsRequest":     application.ArchiveJobsRequest{},
+		"BulkJobsRequest":        application.BulkJobsRequest{},
+		"BulkOperation":          application.BulkOperation{},
+		"CreateReminderRequest":  application.CreateReminderRequest{},
+		"CreateChannelRequest":   application.CreateChannelRequest{},
+		"UpdateChannelRequest":   application.UpdateChannelRequest{},
+		"CreateWebhookRequest":   application.CreateWebhookRequest{},
+		"Job":                    domain.Job{},
+		"Problem":                domain.Problem{},
+		"Violation":              domain.Violation{},
+		"Reminder":               domain.Reminder{},
+		"NotificationChannel":    domain.NotificationChannel{},
+		"NotificationDelivery":   domain.NotificationDelivery{},
+		"WebhookSubscription":    domain.WebhookSubscription{},
+		"WebhookDelivery":        domain.WebhookDelivery{},
+		"Event":                  domain.Event{},
+	}
+
+	for name, value := range types {
+		schema, ok := document.Components.Schemas[name]
+		if !assert.True(t, ok, "missing schema %s", name) {
+			continue
+		}
+
+		var fields, required, documented []string
+		structType := reflect.TypeOf(value)
+		for i := 0; i < structType.NumField(); i++ {
+			field := structType.Field(i)
+			jsonName, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if !field.IsExported() || jsonName == "-" {
+				continue
+			}
+			if jsonName == "" {
+				jsonName = field.Name
+			}
+			fields = append(fields, jsonName)
+			if slices.Contains(strings.Split(field.Tag.Get("binding"), ","), "required") {
+				required = append(required, jsonName)
+			}
+		}
+		for property := range schema.Properties {
+			documented = append(documented, property)
+		}
+
+		assert.ElementsMatch(t, fields, documented, "schema %s properties", name)
+		assert.Subset(t, schema.Required, required, "schema %s required", name)
+	}
+}
+
+func TestDocsHandler_ServesSpec(t *testing.T) {
+	r := setupAPIRouter()
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/openapi.json", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, string(infrastructure.OpenAPISpec()), w.Body.String())
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/docs", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "openapi.json")
+	assert.NotRegexp(t, `(src|href)="(https?:)?//`, w.Body.String(), "the docs page must not load files from other hosts")
+
+	for _, asset := range []string{"swagger-ui.css", "swagger-ui-bundle.js"} {
+		assert.Contains(t, w.Body.String(), `"docs/assets/`+asset+`"`)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/docs/assets/"+asset, nil))
+		assert.Equal(t, http.StatusOK, w.Code, asset)
+		assert.NotEmpty(t, w.Body.Bytes(), asset)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/docs/assets/missing.js", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,159 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"job-tracker/tests/mocks"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestJobMutationsWriteOutbox(t *testing.T) {
+	db := setupTestDB(t)
+	repo := infrastructure.NewJobRepository(db)
+	outbox := infrastructure.NewOutboxRepository(db)
+
+	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	assert.NoError(t, repo.CreateJob(job, context.Background()))
+	job.ChangeStatus(domain.JobStatusApplied)
+	assert.NoError(t, repo.UpdateJob(job, context.Background()))
+	assert.NoError(t, repo.DeleteJob(job.Id.String(), context.Background()))
+
+	events, err := outbox.GetEventsAfter(0, 10, context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, events, 3)
+	assert.Equal(t, domain.JobCreated, events[0].Type)
+	assert.Equal(t, domain.JobStatusChanged, events[1].Type)
+	assert.Equal(t, domain.JobStatusPending, events[1].PreviousStatus)
+	assert.Equal(t, domain.JobStatusApplied, events[1].Job.Status)
+	assert.Equal(t, domain.JobDeleted, events[2].Type)
+	assert.Equal(t, "Google", events[2].Job.Company)
+	assert.Less(t, events[0].Sequence, events[1].Sequence)
+}
+
+func TestFailedMutationDoesNotWriteOutbox(t *testing.T) {
+	db := setupTestDB(t)
+	repo := infrastructure.NewJobRepository(db)
+	outbox := infrastructure.NewOutboxRepository(db)
+
+	job := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	assert.NoError(t, repo.CreateJob(job, context.Background()))
+
+	duplicate := domain.NewJob("Google", "Backend", "Go", 100, true, "")
+	duplicate.Id = job.Id
+	assert.Error(t, repo.CreateJob(duplicate, context.Background()))
+
+	events, err := outbox.GetEventsAfter(0, 10, context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+}
+
+func TestEventRelay_CheckpointsPerSubscriber(t *testing.T) {
+	db := setupTestDB(t)
+	repo := infrastructure.NewJobRepository(db)
+	outbox := infrastructure.NewOutboxRepository(db)
+
+	for _, company := range []string{"A", "B", "C"} {
+		assert.NoError(t, repo.CreateJob(domain.NewJob(company, "Backend", "Go", 100, true, ""), context.Background()))
+	}
+
+	healthy := &mocks.EventSubscriberMock{SubscriberName: "healthy"}
+	failing := &mocks.EventSubscriberMock{SubscriberName: "failing", FailOn: 2}
+	relay := application.NewEventRelay(outbox, []domain.EventSubscriber{healthy, failing}, &mocks.LoggerMock{})
+
+	assert.NoError(t, relay.RelayPending(context.Background()))
+	assert.Len(t, healthy.Events, 3)
+	assert.Len(t, failing.Events, 1)
+
+	failing.FailOn = 0
+	assert.NoError(t, relay.RelayPending(context.Background()))
+	assert.Len(t, healthy.Events, 3)
+	assert.Len(t, failing.Events, 3)
+	assert.Equal(t, int64(2), failing.Events[1].Sequence)
+	assert.Equal(t, "C", failing.Events[2].Job.Company)
+}
+
+// commitOutboxMessage writes an event at an explicit sequence, as a
+// transaction that took that sequence would on commit.
+func commitOutboxMessage(t *testing.T, db *gorm.DB, sequence int64, occurredAt time.Time) {
+	event := domain.NewEvent(domain.JobCreated, uuid.New(), nil)
+	event.OccurredAt = occurredAt
+	payload, err := json.Marshal(event)
+	assert.NoError(t, err)
+	assert.NoError(t, db.Create(&domain.OutboxMessage{
+		Sequence:   sequence,
+		EventId:    event.Id,
+		Type:       event.Type,
+		JobId:      event.JobId,
+		Payload:    string(payload),
+		OccurredAt: event.OccurredAt,
+	}).Error)
+}
+
+func TestEventRelay_WaitsForEarlierSequenceToCommit(t *testing.T) {
+	db := setupTestDB(t)
+	subscriber := &mocks.EventSubscriberMock{SubscriberName: "subscriber"}
+	relay := application.NewEventRelay(infrastructure.NewOutboxRepository(db), []domain.EventSubscriber{subscriber}, &mocks.LoggerMock{})
+
+	commitOutboxMessage(t, db, 2, time.Now())
+	assert.NoError(t, relay.RelayPending(context.Background()))
+	assert.Empty(t, subscriber.Events)
+
+	commitOutboxMessage(t, db, 1, time.Now())
+	assert.NoError(t, relay.RelayPending(context.Background()))
+	assert.Len(t, subscriber.Events, 2)
+	assert.Equal(t, int64(1), subscriber.Events[0].Sequence)
+	assert.Equal(t, int64(2), subscriber.Events[1].Sequence)
+}
+
+func TestEventRelay_SkipsStaleGap(t *testing.T) {
+	db := setupTestDB(t)
+	subscriber := &mocks.EventSubscriberMock{SubscriberName: "subscriber"}
+	relay := application.NewEventRelay(infrastructure.NewOutboxRepository(db), []domain.EventSubscriber{subscriber}, &mocks.LoggerMock{})
+
+	commitOutboxMessage(t, db, 1, time.Now())
+	commitOutboxMessage(t, db, 3, time.Now().Add(-time.Hour))
+	commitOutboxMessage(t, db, 4, time.Now())
+	assert.NoError(t, relay.RelayPending(context.Background()))
+	assert.Len(t, subscriber.Events, 3)
+	assert.Equal(t, int64(3), subscriber.Events[1].Sequence)
+}
+
+func TestEventRelay_StreamPerReplica(t *testing.T) {
+	db := setupTestDB(t)
+	repo := infrastructure.NewJobRepository(db)
+	outbox := infrastructure.NewOutboxRepository(db)
+	assert.NoError(t, repo.CreateJob(domain.NewJob("Before", "Backend", "Go", 100, true, ""), context.Background()))
+
+	var listeners []<-chan domain.Event
+	var relays []*application.EventRelay
+	for range 2 {
+		stream := application.NewEventStream(outbox, &mocks.LoggerMock{})
+		listener, unsubscribe := stream.Subscribe()
+		t.Cleanup(unsubscribe)
+		listeners = append(listeners, listener)
+		relay := application.NewEventRelay(outbox, []domain.EventSubscriber{stream}, &mocks.LoggerMock{})
+		assert.NoError(t, relay.RelayPending(context.Background()))
+		relays = append(relays, relay)
+	}
+
+	assert.NoError(t, repo.CreateJob(domain.NewJob("After", "Backend", "Go", 100, true, ""), context.Background()))
+	for _, relay := range relays {
+		assert.NoError(t, relay.RelayPending(context.Background()))
+	}
+
+	// Each replica relays the new event to its own listeners, and neither
+	// replays what was there before it booted.
+	for _, listener := range listeners {
+		if assert.Len(t, listener, 1) {
+			assert.Equal(t, "After", (<-listener).Job.Company)
+		}
+	}
+}
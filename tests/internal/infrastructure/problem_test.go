@@ -0,0 +1,104 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"job-tracker/tests/mocks"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func decodeProblem(t *testing.T, w *httptest.ResponseRecorder) domain.Problem {
+	assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+	var problem domain.Problem
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	assert.Equal(t, w.Code, problem.Status)
+	return problem
+}
+
+func TestCreateJob_ValidationProblem(t *testing.T) {
+	r, _ := setupJobRouter(t)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/jobs", strings.NewReader(`{"company":"A","description":"Go dev"}`)))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	problem := decodeProblem(t, w)
+	assert.Equal(t, "/problems/validation-error", problem.Type)
+	assert.Equal(t, "/jobs", problem.Instance)
+	assert.ElementsMatch(t, []domain.Violation{
+		{Field: "company", Rule: "min", Message: "must have at least 2 characters"},
+		{Field: "position", Rule: "required", Message: "is required"},
+	}, problem.Violations)
+}
+
+func TestCreateJob_TypeMismatchProblem(t *testing.T) {
+	r, _ := setupJobRouter(t)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/jobs", strings.NewReader(`{"company":"Google","position":"Go","description":"Go dev","salary":"a lot"}`)))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	problem := decodeProblem(t, w)
+	assert.Equal(t, []domain.Violation{{Field: "salary", Rule: "type", Message: "must be a int"}}, problem.Violations)
+}
+
+func TestCreateChannel_TargetMustMatchKind(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	service := application.NewNotificationService(infrastructure.NewNotificationRepository(setupTestDB(t)), nil, &mocks.LoggerMock{})
+	r := gin.New()
+	infrastructure.NewNotificationHandler(service, &mocks.LoggerMock{}).RegisterRoutes(r)
+
+	cases := []struct {
+		body      string
+		status    int
+		violation domain.Violation
+	}{
+		{`{"kind":"EMAIL","target":"alice@example.com\r\nBcc: eve@example.com","events":["POSTING_CLOSED"]}`, http.StatusBadRequest,
+			domain.Violation{Field: "target", Rule: "email", Message: "must be a valid email address"}},
+		{`{"kind":"SLACK","target":"alice@example.com","events":["POSTING_CLOSED"]}`, http.StatusBadRequest,
+			domain.Violation{Field: "target", Rule: "http_url", Message: "must be a valid http or https URL"}},
+		{`{"kind":"WEBHOOK","target":"ftp://hooks.example.com","events":["POSTING_CLOSED"]}`, http.StatusBadRequest,
+			domain.Violation{Field: "target", Rule: "http_url", Message: "must be a valid http or https URL"}},
+		{`{"kind":"EMAIL","target":"alice@example.com","events":["POSTING_CLOSED"]}`, http.StatusCreated, domain.Violation{}},
+		{`{"kind":"SLACK","target":"https://hooks.slack.com/services/T0","events":["POSTING_CLOSED"]}`, http.StatusCreated, domain.Violation{}},
+	}
+	for _, tc := range cases {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/users/alice/channels", strings.NewReader(tc.body)))
+
+		assert.Equal(t, tc.status, w.Code, tc.body)
+		if tc.status == http.StatusBadRequest {
+			assert.Equal(t, []domain.Violation{tc.violation}, decodeProblem(t, w).Violations)
+		}
+	}
+}
+
+func TestGetJob_NotFoundProblemHasTraceId(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	service := application.NewJobService(infrastructure.NewJobRepository(setupTestDB(t)), &mocks.LoggerMock{}, noop.NewTracerProvider())
+	r := gin.New()
+	r.Use(otelgin.Middleware("test", otelgin.WithTracerProvider(sdktrace.NewTracerProvider())))
+	infrastructure.NewJobHandler(service, &mocks.LoggerMock{}).RegisterRoutes(r)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/jobs/"+uuid.NewString(), nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	problem := decodeProblem(t, w)
+	assert.Equal(t, "/problems/not-found", problem.Type)
+	assert.Equal(t, "Not Found", problem.Title)
+	assert.Equal(t, domain.ErrJobNotFound.Error(), problem.Detail)
+	assert.Len(t, problem.TraceId, 32)
+}
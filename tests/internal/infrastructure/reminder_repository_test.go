@@ -0,0 +1,100 @@
+package infrastructure
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDueBefore(t *testing.T) {
+	db := setupTestDB(t)
+	repo := infrastructure.NewReminderRepository(db)
+	jobs := infrastructure.NewJobRepository(db)
+	job := domain.NewJob("Google", "Backend", "Go dev", 100, true, "")
+	deleted := domain.NewJob("Meta", "Frontend", "React", 100, true, "")
+	for _, j := range []*domain.Job{job, deleted} {
+		assert.NoError(t, jobs.CreateJob(j, context.Background()))
+	}
+	assert.NoError(t, jobs.DeleteJob(deleted.Id.String(), context.Background()))
+
+	now := time.Now()
+	due := domain.NewReminder(job.Id, now.Add(-time.Hour), "due", domain.ReminderRecurrenceNone)
+	later := domain.NewReminder(job.Id, now.Add(time.Hour), "later", domain.ReminderRecurrenceNone)
+	done := domain.NewReminder(job.Id, now.Add(-time.Hour), "done", domain.ReminderRecurrenceNone)
+	done.Fire(now)
+	trashed := domain.NewReminder(deleted.Id, now.Add(-time.Hour), "trashed", domain.ReminderRecurrenceNone)
+
+	for _, reminder := range []*domain.Reminder{due, later, done, trashed} {
+		assert.NoError(t, repo.CreateReminder(reminder, context.Background()))
+	}
+
+	reminders, err := repo.GetDueBefore(now, context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, reminders, 1)
+	assert.Equal(t, due.Id, reminders[0].Id)
+	assert.Equal(t, "due", reminders[0].Message)
+
+	pending, err := repo.GetPending(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, pending, 2)
+	for _, reminder := range pending {
+		assert.NotEqual(t, trashed.Id, reminder.Id)
+	}
+}
+
+func TestCompleteRuleReminders(t *testing.T) {
+	repo := infrastructure.NewReminderRepository(setupTestDB(t))
+
+	jobId, applied, interview := uuid.New(), uuid.New(), uuid.New()
+	stale := domain.NewReminder(jobId, time.Now(), "follow up", domain.ReminderRecurrenceNone)
+	stale.EventId = &applied
+	current := domain.NewReminder(jobId, time.Now(), "after the interview", domain.ReminderRecurrenceNone)
+	current.EventId = &interview
+	manual := domain.NewReminder(jobId, time.Now(), "manual", domain.ReminderRecurrenceNone)
+	other := domain.NewReminder(uuid.New(), time.Now(), "other job", domain.ReminderRecurrenceNone)
+	other.EventId = &applied
+	for _, reminder := range []*domain.Reminder{stale, current, manual, other} {
+		assert.NoError(t, repo.CreateReminder(reminder, context.Background()))
+	}
+
+	assert.NoError(t, repo.CompleteRuleReminders(jobId.String(), interview, context.Background()))
+
+	reminders, err := repo.GetByJobIds([]string{jobId.String(), other.JobId.String()}, context.Background())
+	assert.NoError(t, err)
+	done := map[uuid.UUID]bool{}
+	for _, reminder := range reminders {
+		done[reminder.Id] = reminder.Done
+	}
+	assert.Equal(t, map[uuid.UUID]bool{stale.Id: true, current.Id: false, manual.Id: false, other.Id: false}, done)
+
+	assert.NoError(t, repo.CompleteRuleReminders(jobId.String(), uuid.Nil, context.Background()))
+	reminders, err = repo.GetByJobIds([]string{jobId.String()}, context.Background())
+	assert.NoError(t, err)
+	for _, reminder := range reminders {
+		assert.Equal(t, reminder.Id != manual.Id, reminder.Done)
+	}
+}
+
+func TestCreateReminder_SkipsRedeliveredEvent(t *testing.T) {
+	repo := infrastructure.NewReminderRepository(setupTestDB(t))
+
+	jobId, eventId := uuid.New(), uuid.New()
+	for range 2 {
+		reminder := domain.NewReminder(jobId, time.Now(), "follow up", domain.ReminderRecurrenceNone)
+		reminder.EventId = &eventId
+		assert.NoError(t, repo.CreateReminder(reminder, context.Background()))
+	}
+	// Reminders created by hand carry no event and never collide.
+	for range 2 {
+		assert.NoError(t, repo.CreateReminder(domain.NewReminder(jobId, time.Now(), "manual", domain.ReminderRecurrenceNone), context.Background()))
+	}
+
+	reminders, err := repo.GetByJobIds([]string{jobId.String()}, context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, reminders, 3)
+}
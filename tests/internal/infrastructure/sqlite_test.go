@@ -0,0 +1,55 @@
+package infrastructure
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenSQLite_AppliesPragmas(t *testing.T) {
+	db := setupTestDB(t)
+
+	var journalMode string
+	var foreignKeys, busyTimeout int
+	assert.NoError(t, db.Raw("PRAGMA journal_mode").Scan(&journalMode).Error)
+	assert.NoError(t, db.Raw("PRAGMA foreign_keys").Scan(&foreignKeys).Error)
+	assert.NoError(t, db.Raw("PRAGMA busy_timeout").Scan(&busyTimeout).Error)
+
+	assert.Equal(t, "wal", journalMode)
+	assert.Equal(t, 1, foreignKeys)
+	assert.Equal(t, 5000, busyTimeout)
+}
+
+func TestOpenSQLite_ConcurrentWriters(t *testing.T) {
+	db := setupTestDB(t)
+	repo := infrastructure.NewJobRepository(db)
+	jobs := make([]*domain.Job, 20)
+	for i := range jobs {
+		jobs[i] = domain.NewJob("Google", "Backend", "Go dev", 0, false, "")
+		assert.NoError(t, repo.CreateJob(jobs[i], context.Background()))
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(jobs))
+	for _, job := range jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			job.ChangeStatus(domain.JobStatusApplied)
+			errs <- repo.UpdateJob(job, context.Background())
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+	all, err := repo.GetJobsByStatus(domain.JobStatusApplied, false, context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, all, len(jobs))
+}
@@ -0,0 +1,170 @@
+package infrastructure
+
+import (
+	"context"
+	"job-tracker/internal/application"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"job-tracker/tests/mocks"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func setupWeb(t *testing.T) (*gin.Engine, *application.JobService) {
+	gin.SetMode(gin.TestMode)
+	db := setupTestDB(t)
+	jobs := application.NewJobService(infrastructure.NewJobRepository(db), &mocks.LoggerMock{}, noop.NewTracerProvider())
+	stream := application.NewEventStream(infrastructure.NewOutboxRepository(db), &mocks.LoggerMock{})
+
+	r := gin.New()
+	infrastructure.NewWebHandler(jobs, stream, infrastructure.NewPostingReader(), &mocks.LoggerMock{}).RegisterRoutes(r)
+	return r, jobs
+}
+
+func postForm(r http.Handler, target string, form url.Values, fragment bool) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if fragment {
+		req.Header.Set("HX-Request", "true")
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestWebBoard_GroupsJobsByStatus(t *testing.T) {
+	r, jobs := setupWeb(t)
+	google, _ := jobs.CreateJob(&application.CreateJobRequest{Company: "Google", Position: "Backend", Description: "Go dev"}, context.Background())
+	_, _ = jobs.UpdateJobStatus(google.Id, &application.UpdateJobStatusRequest{Status: "INTERVIEW"}, context.Background())
+	_, _ = jobs.CreateJob(&application.CreateJobRequest{Company: "Amazon", Position: "SRE", Description: "Ops"}, context.Background())
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ui", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	body := w.Body.String()
+	assert.Contains(t, body, "<!doctype html>")
+	interview := strings.Index(body, `data-status="INTERVIEW"`)
+	pending := strings.Index(body, `data-status="PENDING"`)
+	assert.Greater(t, strings.Index(body, "Amazon"), pending)
+	assert.Less(t, strings.Index(body, "Amazon"), interview)
+	assert.Greater(t, strings.Index(body, "Google"), interview)
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ui/board", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "<!doctype html>")
+	assert.Contains(t, w.Body.String(), `id="board"`)
+}
+
+func TestWebStatus_ReturnsFragment(t *testing.T) {
+	r, jobs := setupWeb(t)
+	job, _ := jobs.CreateJob(&application.CreateJobRequest{Company: "Google", Position: "Backend", Description: "Go dev"}, context.Background())
+
+	w := postForm(r, "/ui/jobs/"+job.Id.String()+"/status?view=board", url.Values{"status": {"APPLIED"}, "version": {strconv.Itoa(job.Version)}}, true)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "<!doctype html>")
+	assert.Contains(t, w.Body.String(), `id="board"`)
+	updated, _ := jobs.GetJob(job.Id, context.Background())
+	assert.Equal(t, domain.JobStatusApplied, updated.Status)
+
+	w = postForm(r, "/ui/jobs/"+job.Id.String()+"/status", url.Values{"status": {"OFFER"}, "version": {strconv.Itoa(job.Version)}}, true)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), `id="detail"`)
+	assert.Contains(t, w.Body.String(), "changed somewhere else")
+}
+
+func TestWebDetail_ShowsTimelineAndNotes(t *testing.T) {
+	r, jobs := setupWeb(t)
+	job, _ := jobs.CreateJob(&application.CreateJobRequest{Company: "Google", Position: "Backend", Description: "Go dev"}, context.Background())
+	job, _ = jobs.UpdateJobStatus(job.Id, &application.UpdateJobStatusRequest{Status: "APPLIED"}, context.Background())
+
+	w := postForm(r, "/ui/jobs/"+job.Id.String()+"/notes", url.Values{"notes": {"Ask about on-call"}, "version": {strconv.Itoa(job.Version)}}, true)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ui/jobs/"+job.Id.String(), nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	body := w.Body.String()
+	assert.Contains(t, body, "Ask about on-call")
+	assert.Contains(t, body, "Added")
+	assert.Contains(t, body, "Status PENDING → APPLIED")
+	assert.Contains(t, body, "Details updated")
+}
+
+func TestWebCreateJob_ReadsPosting(t *testing.T) {
+	r, jobs := setupWeb(t)
+	posting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html><head>
+<meta property="og:site_name" content="Acme">
+<meta property="og:title" content="Platform Engineer">
+<meta property="og:description" content="Run the platform">
+</head></html>`))
+	}))
+	defer posting.Close()
+
+	w := postForm(r, "/ui/jobs", url.Values{"url": {posting.URL}, "salary": {"90000"}}, false)
+
+	assert.Equal(t, http.StatusSeeOther, w.Code)
+	all, _ := jobs.GetAllJobs(false, context.Background())
+	if assert.Len(t, all, 1) {
+		assert.Equal(t, "Acme", all[0].Company)
+		assert.Equal(t, "Platform Engineer", all[0].Position)
+		assert.Equal(t, 90000, all[0].Salary)
+		assert.Equal(t, "/ui/jobs/"+all[0].Id.String(), w.Header().Get("Location"))
+	}
+
+	w = postForm(r, "/ui/jobs", url.Values{"url": {"ftp://example.com"}}, false)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), "Could not read the posting")
+	assert.Contains(t, w.Body.String(), `value="ftp://example.com"`)
+}
+
+func TestWebStatic_ServesAssets(t *testing.T) {
+	r, _ := setupWeb(t)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ui/static/app.js", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "hx-post")
+}
+
+func TestWebStats_ExcludesArchivedUnlessAsked(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db := setupTestDB(t)
+	repo := infrastructure.NewJobRepository(db)
+	jobs := application.NewJobService(repo, &mocks.LoggerMock{}, noop.NewTracerProvider())
+	r := gin.New()
+	infrastructure.NewWebHandler(jobs, application.NewEventStream(infrastructure.NewOutboxRepository(db), &mocks.LoggerMock{}),
+		infrastructure.NewPostingReader(), &mocks.LoggerMock{}).RegisterRoutes(r)
+
+	archived := domain.NewJob("Amazon", "SRE", "Ops", 0, false, "")
+	archived.Archive(domain.FieldSourceUser)
+	for _, job := range []*domain.Job{archived, domain.NewJob("Google", "Backend", "Go dev", 0, true, "")} {
+		assert.NoError(t, repo.CreateJob(job, context.Background()))
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ui/stats", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "<strong>1</strong> jobs")
+	assert.NotContains(t, w.Body.String(), "archived</div>")
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ui/stats?includeArchived=true", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "<strong>2</strong> jobs")
+	assert.Contains(t, w.Body.String(), "<strong>1</strong> archived")
+}
@@ -0,0 +1,25 @@
+package infrastructure
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/infrastructure"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateDelivery_SkipsRedeliveredEvent(t *testing.T) {
+	repo := infrastructure.NewWebhookRepository(setupTestDB(t))
+	subscription := domain.NewWebhookSubscription("http://hooks", "0123456789abcdef", nil)
+	assert.NoError(t, repo.CreateSubscription(subscription, context.Background()))
+
+	job := domain.NewJob("Google", "Backend", "Go dev", 100000, true, "")
+	event := domain.NewEvent(domain.JobCreated, job.Id, job)
+	assert.NoError(t, repo.CreateDelivery(domain.NewWebhookDelivery(subscription.Id, event, "{}"), context.Background()))
+	assert.NoError(t, repo.CreateDelivery(domain.NewWebhookDelivery(subscription.Id, event, "{}"), context.Background()))
+
+	deliveries, err := repo.GetDeliveriesBySubscription(subscription.Id.String(), context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, deliveries, 1)
+}
@@ -0,0 +1,156 @@
+package tui
+
+import (
+	"context"
+	"job-tracker/internal/application"
+	"job-tracker/internal/client"
+	"job-tracker/internal/domain"
+	"job-tracker/internal/tui"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+// driver runs the commands returned by the model in the background and
+// feeds their messages back, like tea.Program does.
+type driver struct {
+	t     *testing.T
+	model tea.Model
+	msgs  chan tea.Msg
+}
+
+func newDriver(t *testing.T, backend client.Backend) *driver {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	d := &driver{t: t, model: tui.New(backend, ctx), msgs: make(chan tea.Msg, 16)}
+	d.run(d.model.Init())
+	d.send(tea.WindowSizeMsg{Width: 200, Height: 40})
+	return d
+}
+
+func (d *driver) run(cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	go func() {
+		msg := cmd()
+		if batch, ok := msg.(tea.BatchMsg); ok {
+			for _, cmd := range batch {
+				d.run(cmd)
+			}
+			return
+		}
+		if msg != nil {
+			d.msgs <- msg
+		}
+	}()
+}
+
+func (d *driver) send(msg tea.Msg) {
+	model, cmd := d.model.Update(msg)
+	d.model = model
+	d.run(cmd)
+}
+
+func (d *driver) keys(keys ...tea.KeyMsg) {
+	for _, key := range keys {
+		d.send(key)
+	}
+}
+
+func (d *driver) waitFor(condition func(view string) bool) {
+	d.t.Helper()
+	timeout := time.After(5 * time.Second)
+	for !condition(d.model.View()) {
+		select {
+		case msg := <-d.msgs:
+			d.send(msg)
+		case <-timeout:
+			d.t.Fatalf("condition not met, view:\n%s", d.model.View())
+		}
+	}
+}
+
+// focus moves the cursor from the first column to the column of status.
+func (d *driver) focus(status domain.JobStatus) {
+	for range slices.Index(domain.JobStatuses, status) {
+		d.send(runes("l"))
+	}
+}
+
+func runes(value string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(value)}
+}
+
+func setupBoard(t *testing.T) (string, client.Backend, *domain.Job) {
+	path := filepath.Join(t.TempDir(), "jobs.db")
+	backend, err := client.OpenLocalBackend(path)
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = backend.Close() })
+
+	ctx := context.Background()
+	job, err := backend.CreateJob(&application.CreateJobRequest{Company: "Google", Position: "Backend", Description: "Go dev"}, ctx)
+	assert.NoError(t, err)
+	_, err = backend.CreateJob(&application.CreateJobRequest{Company: "Amazon", Position: "Java", Description: "Spring"}, ctx)
+	assert.NoError(t, err)
+	return path, backend, job
+}
+
+func TestBoard_MovesCardsBetweenColumns(t *testing.T) {
+	_, backend, job := setupBoard(t)
+	d := newDriver(t, backend)
+	d.waitFor(func(view string) bool { return strings.Contains(view, "PENDING (2)") })
+
+	d.keys(runes("/"), runes("goo"), tea.KeyMsg{Type: tea.KeyEnter})
+	d.waitFor(func(view string) bool { return strings.Contains(view, "PENDING (1)") })
+	assert.NotContains(t, d.model.View(), "Amazon")
+
+	d.focus(domain.JobStatusPending)
+	d.keys(runes("L"))
+	d.waitFor(func(view string) bool { return strings.Contains(view, "APPLIED (1)") })
+
+	moved, err := backend.GetJob(job.Id, context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, domain.JobStatusApplied, moved.Status)
+}
+
+func TestBoard_EditsNotes(t *testing.T) {
+	_, backend, job := setupBoard(t)
+	d := newDriver(t, backend)
+	d.waitFor(func(view string) bool { return strings.Contains(view, "PENDING (2)") })
+	d.keys(runes("/"), runes("google"), tea.KeyMsg{Type: tea.KeyEnter})
+
+	d.focus(domain.JobStatusPending)
+	d.keys(tea.KeyMsg{Type: tea.KeyEnter})
+	d.waitFor(func(view string) bool {
+		return strings.Contains(view, "Go dev") && strings.Contains(view, "No notes yet")
+	})
+
+	d.keys(runes("n"), runes("Call back on Monday"), tea.KeyMsg{Type: tea.KeyCtrlS})
+	d.waitFor(func(view string) bool {
+		return strings.Contains(view, "Call back on Monday") && !strings.Contains(view, "ctrl+s")
+	})
+
+	saved, err := backend.GetJob(job.Id, context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "Call back on Monday", saved.Notes)
+}
+
+func TestBoard_RefreshesFromEvents(t *testing.T) {
+	path, backend, _ := setupBoard(t)
+	d := newDriver(t, backend)
+	d.waitFor(func(view string) bool { return strings.Contains(view, "PENDING (2)") })
+
+	other, err := client.OpenLocalBackend(path)
+	assert.NoError(t, err)
+	defer other.Close()
+	_, err = other.CreateJob(&application.CreateJobRequest{Company: "Meta", Position: "Infra", Description: "Go"}, context.Background())
+	assert.NoError(t, err)
+
+	d.waitFor(func(view string) bool { return strings.Contains(view, "PENDING (3)") && strings.Contains(view, "Meta") })
+}
@@ -0,0 +1,24 @@
+package mocks
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+)
+
+type EventSubscriberMock struct {
+	SubscriberName string
+	FailOn         int64
+	Events         []domain.Event
+}
+
+func (m *EventSubscriberMock) Name() string {
+	return m.SubscriberName
+}
+
+func (m *EventSubscriberMock) Handle(ctx context.Context, event domain.Event) error {
+	if event.Sequence == m.FailOn {
+		return domain.ErrInternalServer
+	}
+	m.Events = append(m.Events, event)
+	return nil
+}
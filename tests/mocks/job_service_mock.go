@@ -1,7 +1,9 @@
 package mocks
 
 import (
+	"context"
 	"job-tracker/internal/domain"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -10,12 +12,12 @@ type JobRepositoryMock struct {
 	mock.Mock
 }
 
-func (m *JobRepositoryMock) CreateJob(job *domain.Job) error {
+func (m *JobRepositoryMock) CreateJob(job *domain.Job, ctx context.Context) error {
 	args := m.Called(job)
 	return args.Error(0)
 }
 
-func (m *JobRepositoryMock) GetJobById(id string) (*domain.Job, error) {
+func (m *JobRepositoryMock) GetJobById(id string, ctx context.Context) (*domain.Job, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -23,22 +25,54 @@ func (m *JobRepositoryMock) GetJobById(id string) (*domain.Job, error) {
 	return args.Get(0).(*domain.Job), args.Error(1)
 }
 
-func (m *JobRepositoryMock) GetAll() ([]*domain.Job, error) {
-	args := m.Called()
+func (m *JobRepositoryMock) GetJobsByIds(ids []string, ctx context.Context) ([]*domain.Job, error) {
+	args := m.Called(ids)
+	return args.Get(0).([]*domain.Job), args.Error(1)
+}
+
+func (m *JobRepositoryMock) GetAll(includeArchived bool, ctx context.Context) ([]*domain.Job, error) {
+	args := m.Called(includeArchived)
 	return args.Get(0).([]*domain.Job), args.Error(1)
 }
 
-func (m *JobRepositoryMock) UpdateJob(job *domain.Job) error {
+func (m *JobRepositoryMock) UpdateJob(job *domain.Job, ctx context.Context) error {
 	args := m.Called(job)
 	return args.Error(0)
 }
 
-func (m *JobRepositoryMock) DeleteJob(id string) error {
+func (m *JobRepositoryMock) DeleteJob(id string, ctx context.Context) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *JobRepositoryMock) GetJobsByStatus(status domain.JobStatus) ([]*domain.Job, error) {
-	args := m.Called(status)
+func (m *JobRepositoryMock) GetJobsByStatus(status domain.JobStatus, includeArchived bool, ctx context.Context) ([]*domain.Job, error) {
+	args := m.Called(status, includeArchived)
 	return args.Get(0).([]*domain.Job), args.Error(1)
 }
+
+func (m *JobRepositoryMock) CountByStatus(includeArchived bool, ctx context.Context) (map[domain.JobStatus]int64, error) {
+	args := m.Called(includeArchived)
+	return args.Get(0).(map[domain.JobStatus]int64), args.Error(1)
+}
+
+func (m *JobRepositoryMock) GetDeleted(ctx context.Context) ([]*domain.Job, error) {
+	args := m.Called()
+	return args.Get(0).([]*domain.Job), args.Error(1)
+}
+
+func (m *JobRepositoryMock) RestoreJob(id string, ctx context.Context) (*domain.Job, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Job), args.Error(1)
+}
+
+func (m *JobRepositoryMock) PurgeDeleted(before time.Time, ctx context.Context) (int64, error) {
+	args := m.Called(before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *JobRepositoryMock) Transaction(fn func(repository domain.JobRepository) error, ctx context.Context) error {
+	return fn(m)
+}
@@ -0,0 +1,80 @@
+package mocks
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type NotificationRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *NotificationRepositoryMock) CreateChannel(channel *domain.NotificationChannel, ctx context.Context) error {
+	args := m.Called(channel)
+	return args.Error(0)
+}
+
+func (m *NotificationRepositoryMock) UpdateChannel(channel *domain.NotificationChannel, ctx context.Context) error {
+	args := m.Called(channel)
+	return args.Error(0)
+}
+
+func (m *NotificationRepositoryMock) GetChannelById(id string, ctx context.Context) (*domain.NotificationChannel, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.NotificationChannel), args.Error(1)
+}
+
+func (m *NotificationRepositoryMock) GetChannelsByUser(userId string, ctx context.Context) ([]*domain.NotificationChannel, error) {
+	args := m.Called(userId)
+	return args.Get(0).([]*domain.NotificationChannel), args.Error(1)
+}
+
+func (m *NotificationRepositoryMock) GetEnabledChannels(userId string, ctx context.Context) ([]*domain.NotificationChannel, error) {
+	args := m.Called(userId)
+	return args.Get(0).([]*domain.NotificationChannel), args.Error(1)
+}
+
+func (m *NotificationRepositoryMock) DeleteChannel(id string, ctx context.Context) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *NotificationRepositoryMock) CreateDelivery(delivery *domain.NotificationDelivery, ctx context.Context) error {
+	args := m.Called(delivery)
+	return args.Error(0)
+}
+
+func (m *NotificationRepositoryMock) UpdateDelivery(delivery *domain.NotificationDelivery, ctx context.Context) error {
+	args := m.Called(delivery)
+	return args.Error(0)
+}
+
+func (m *NotificationRepositoryMock) GetDeliveriesByChannel(channelId string, ctx context.Context) ([]*domain.NotificationDelivery, error) {
+	args := m.Called(channelId)
+	return args.Get(0).([]*domain.NotificationDelivery), args.Error(1)
+}
+
+func (m *NotificationRepositoryMock) GetDueDeliveries(now time.Time, limit int, ctx context.Context) ([]*domain.NotificationDelivery, error) {
+	args := m.Called(now, limit)
+	return args.Get(0).([]*domain.NotificationDelivery), args.Error(1)
+}
+
+type NotificationSenderMock struct {
+	mock.Mock
+	ChannelKind domain.ChannelKind
+}
+
+func (m *NotificationSenderMock) Kind() domain.ChannelKind {
+	return m.ChannelKind
+}
+
+func (m *NotificationSenderMock) Send(ctx context.Context, channel *domain.NotificationChannel, notification domain.Notification) error {
+	args := m.Called(channel, notification)
+	return args.Error(0)
+}
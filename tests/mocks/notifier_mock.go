@@ -0,0 +1,17 @@
+package mocks
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type NotifierMock struct {
+	mock.Mock
+}
+
+func (m *NotifierMock) Notify(ctx context.Context, notification domain.Notification) error {
+	args := m.Called(notification)
+	return args.Error(0)
+}
@@ -0,0 +1,44 @@
+package mocks
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type ReminderRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *ReminderRepositoryMock) CreateReminder(reminder *domain.Reminder, ctx context.Context) error {
+	args := m.Called(reminder)
+	return args.Error(0)
+}
+
+func (m *ReminderRepositoryMock) UpdateReminder(reminder *domain.Reminder, ctx context.Context) error {
+	args := m.Called(reminder)
+	return args.Error(0)
+}
+
+func (m *ReminderRepositoryMock) GetPending(ctx context.Context) ([]*domain.Reminder, error) {
+	args := m.Called()
+	return args.Get(0).([]*domain.Reminder), args.Error(1)
+}
+
+func (m *ReminderRepositoryMock) GetDueBefore(before time.Time, ctx context.Context) ([]*domain.Reminder, error) {
+	args := m.Called(before)
+	return args.Get(0).([]*domain.Reminder), args.Error(1)
+}
+
+func (m *ReminderRepositoryMock) GetByJobIds(jobIds []string, ctx context.Context) ([]*domain.Reminder, error) {
+	args := m.Called(jobIds)
+	return args.Get(0).([]*domain.Reminder), args.Error(1)
+}
+
+func (m *ReminderRepositoryMock) CompleteRuleReminders(jobId string, keep uuid.UUID, ctx context.Context) error {
+	args := m.Called(jobId, keep)
+	return args.Error(0)
+}
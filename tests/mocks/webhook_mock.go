@@ -0,0 +1,73 @@
+package mocks
+
+import (
+	"context"
+	"job-tracker/internal/domain"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type WebhookRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *WebhookRepositoryMock) CreateSubscription(subscription *domain.WebhookSubscription, ctx context.Context) error {
+	args := m.Called(subscription)
+	return args.Error(0)
+}
+
+func (m *WebhookRepositoryMock) GetSubscriptionById(id string, ctx context.Context) (*domain.WebhookSubscription, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WebhookSubscription), args.Error(1)
+}
+
+func (m *WebhookRepositoryMock) GetSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	args := m.Called()
+	return args.Get(0).([]*domain.WebhookSubscription), args.Error(1)
+}
+
+func (m *WebhookRepositoryMock) DeleteSubscription(id string, ctx context.Context) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *WebhookRepositoryMock) CreateDelivery(delivery *domain.WebhookDelivery, ctx context.Context) error {
+	args := m.Called(delivery)
+	return args.Error(0)
+}
+
+func (m *WebhookRepositoryMock) UpdateDelivery(delivery *domain.WebhookDelivery, ctx context.Context) error {
+	args := m.Called(delivery)
+	return args.Error(0)
+}
+
+func (m *WebhookRepositoryMock) GetDeliveryById(id string, ctx context.Context) (*domain.WebhookDelivery, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WebhookDelivery), args.Error(1)
+}
+
+func (m *WebhookRepositoryMock) GetDeliveriesBySubscription(subscriptionId string, ctx context.Context) ([]*domain.WebhookDelivery, error) {
+	args := m.Called(subscriptionId)
+	return args.Get(0).([]*domain.WebhookDelivery), args.Error(1)
+}
+
+func (m *WebhookRepositoryMock) GetDueDeliveries(now time.Time, limit int, ctx context.Context) ([]*domain.WebhookDelivery, error) {
+	args := m.Called(now, limit)
+	return args.Get(0).([]*domain.WebhookDelivery), args.Error(1)
+}
+
+type WebhookClientMock struct {
+	mock.Mock
+}
+
+func (m *WebhookClientMock) Post(ctx context.Context, subscription *domain.WebhookSubscription, delivery *domain.WebhookDelivery) (int, error) {
+	args := m.Called(subscription, delivery)
+	return args.Int(0), args.Error(1)
+}